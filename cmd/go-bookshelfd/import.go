@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const importUsage = "usage: go-bookshelfd import -email <user email> [-batch-size N] [-default-total-page N] <file.csv>"
+
+// runImport handles `go-bookshelfd import`, the books are imported to the shelf of the user with the given email
+func runImport(ctx context.Context, uow service.UnitOfWork, validate *validator.Validate, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the user who owns the imported books")
+	batchSize := flags.Int("batch-size", 0, "rows per transaction, the whole file is one transaction when 0")
+	defaultTotalPage := flags.Int("default-total-page", 0, "total_page for rows without a page count")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *email == "" || flags.NArg() != 1 {
+		return errors.New(importUsage)
+	}
+
+	userId, err := findUserIdByEmail(ctx, uow, *email)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	importService := service.NewImportService(uow, validate)
+	report, err := importService.ImportBooks(helper.WithUserId(ctx, userId), web.QueryParamsImportBooks{
+		BatchSize:        *batchSize,
+		DefaultTotalPage: *defaultTotalPage,
+	}, file)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		fmt.Printf("line %d\t%s\t%s by %s", row.Line, row.Result, row.Title, row.AuthorName)
+		if row.Message != "" {
+			fmt.Printf("\t%s", row.Message)
+		}
+		fmt.Println()
+	}
+	fmt.Printf("%s import: %d created, %d skipped, %d failed\n", report.Format, report.Created, report.Skipped, report.Failed)
+
+	return nil
+}
+
+func findUserIdByEmail(ctx context.Context, uow service.UnitOfWork, email string) (string, error) {
+	tx, err := uow.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	user, err := tx.GetUserRepository().FindByEmail(ctx, email)
+	if err != nil {
+		return "", fmt.Errorf("user with email '%s' is not found: %w", email, err)
+	}
+
+	return user.Id, nil
+}
+
+const importClippingsUsage = "usage: go-bookshelfd import-clippings -email <user email> [-format kindle|kobo] <My Clippings.txt or .annot file>"
+
+// runImportClippings handles `go-bookshelfd import-clippings`, the clippings are matched to the books of the user with the given email
+func runImportClippings(ctx context.Context, uow service.UnitOfWork, validate *validator.Validate, args []string) error {
+	flags := flag.NewFlagSet("import-clippings", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the user who owns the books")
+	format := flags.String("format", domain.ImportFormatKindle, "kindle for My Clippings.txt, kobo for a Kobo annotation export")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *email == "" || flags.NArg() != 1 {
+		return errors.New(importClippingsUsage)
+	}
+	if *format != domain.ImportFormatKindle && *format != domain.ImportFormatKobo {
+		return errors.New(importClippingsUsage)
+	}
+
+	userId, err := findUserIdByEmail(ctx, uow, *email)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	importService := service.NewImportService(uow, validate)
+	importClippings := importService.ImportKindleClippings
+	if *format == domain.ImportFormatKobo {
+		importClippings = importService.ImportKoboAnnotations
+	}
+
+	report, err := importClippings(helper.WithUserId(ctx, userId), file)
+	if err != nil {
+		return err
+	}
+
+	for _, book := range report.Books {
+		fmt.Printf("matched\t%s by %s\t%s\t%d imported, %d duplicates\n", book.Title, book.AuthorName, book.BookName, book.Imported, book.Duplicates)
+	}
+	for _, unmatched := range report.Unmatched {
+		fmt.Printf("unmatched\t%s by %s\t%d clippings, add the book and import again\n", unmatched.Title, unmatched.AuthorName, unmatched.Clippings)
+	}
+	fmt.Printf("%s import: %d imported, %d duplicates, %d skipped, %d unmatched titles\n", report.Format, report.Imported, report.Duplicates, report.Skipped, len(report.Unmatched))
+
+	return nil
+}
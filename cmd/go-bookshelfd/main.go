@@ -12,7 +12,10 @@ import (
 	"github.com/mhaatha/go-bookshelf/internal/config"
 	"github.com/mhaatha/go-bookshelf/internal/database"
 	"github.com/mhaatha/go-bookshelf/internal/handler"
+	"github.com/mhaatha/go-bookshelf/internal/infrastructure/metadatafile"
+	"github.com/mhaatha/go-bookshelf/internal/infrastructure/openlibrary"
 	"github.com/mhaatha/go-bookshelf/internal/infrastructure/postgres"
+	"github.com/mhaatha/go-bookshelf/internal/middleware"
 	"github.com/mhaatha/go-bookshelf/internal/router"
 	"github.com/mhaatha/go-bookshelf/internal/service"
 )
@@ -24,7 +27,7 @@ func main() {
 	// Config init
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		slog.Error("godotenv fails to load .env file", "err", err)
+		slog.Error("failed to load config", "err", err)
 		os.Exit(1)
 	}
 
@@ -39,6 +42,45 @@ func main() {
 	}
 	defer db.Close()
 
+	// Subcommands, the server is started when none is given
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			if err := runMigrate(context.Background(), db, os.Args[2:]); err != nil {
+				slog.Error("migration failed", "err", err)
+				os.Exit(1)
+			}
+		case "import":
+			if err := runImport(context.Background(), postgres.NewPgxUnitOfWork(db), validate, os.Args[2:]); err != nil {
+				slog.Error("import failed", "err", err)
+				os.Exit(1)
+			}
+		case "import-clippings":
+			if err := runImportClippings(context.Background(), postgres.NewPgxUnitOfWork(db), validate, os.Args[2:]); err != nil {
+				slog.Error("import failed", "err", err)
+				os.Exit(1)
+			}
+		default:
+			slog.Error("unknown command", "command", os.Args[1])
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Auto migrate, the runner holds an advisory lock so replicas starting together do not race
+	if cfg.AutoMigrate {
+		migrator, err := database.NewMigrator(db)
+		if err != nil {
+			slog.Error("failed to load migrations", "err", err)
+			os.Exit(1)
+		}
+
+		if err := migrator.Up(context.Background()); err != nil {
+			slog.Error("failed to migrate database", "err", err)
+			os.Exit(1)
+		}
+	}
+
 	// MinIO init
 	minioClient, err := config.MinIOInit(cfg)
 	if err != nil {
@@ -53,12 +95,19 @@ func main() {
 	uow := postgres.NewPgxUnitOfWork(db)
 
 	// Author resources
-	authorService := service.NewAuthorService(uow, validate)
+	authorService := service.NewAuthorService(uow, validate, minioClient, cfg)
 	authorHandler := handler.NewAuthorHandler(authorService)
 
 	// Author router
 	router.AuthorRouter(authorHandler, mux)
 
+	// Author alias resources
+	authorAliasService := service.NewAuthorAliasService(uow, validate)
+	authorAliasHandler := handler.NewAuthorAliasHandler(authorAliasService)
+
+	// Author alias router
+	router.AuthorAliasRouter(authorAliasHandler, mux)
+
 	// Upload resources
 	uploadService := service.NewUploadService(minioClient, cfg)
 	uploadHandler := handler.NewUploadHandler(uploadService)
@@ -66,24 +115,124 @@ func main() {
 	// Upload router
 	router.UploadRouter(uploadHandler, mux)
 
+	// Metadata provider init, the file provider serves a local JSON file so lookups work offline
+	var metadataProvider service.MetadataProvider
+	switch cfg.MetadataProvider {
+	case config.MetadataProviderFile:
+		metadataProvider, err = metadatafile.NewMetadataProvider(cfg.MetadataFile)
+		if err != nil {
+			slog.Error("failed to load metadata file", "err", err)
+			os.Exit(1)
+		}
+	case config.MetadataProviderOpenLibrary:
+		metadataProvider = openlibrary.NewMetadataProvider(&http.Client{Timeout: config.MetadataTimeout}, cfg.OpenLibraryURL)
+	default:
+		slog.Error("unknown metadata provider", "provider", cfg.MetadataProvider)
+		os.Exit(1)
+	}
+
 	// Book resources
-	bookService := service.NewBookService(uow, authorService, validate, minioClient, cfg)
+	bookService := service.NewBookService(uow, authorService, validate, minioClient, cfg, metadataProvider)
 	bookhandler := handler.NewBookHandler(bookService)
 
 	// Book router
 	router.BookRouter(bookhandler, mux)
 
+	// Reading session resources
+	readingSessionService := service.NewReadingSessionService(uow, validate)
+	readingSessionHandler := handler.NewReadingSessionHandler(readingSessionService)
+
+	// Reading session router
+	router.ReadingSessionRouter(readingSessionHandler, mux)
+
+	// Import resources
+	importService := service.NewImportService(uow, validate)
+	importHandler := handler.NewImportHandler(importService)
+
+	// Import router
+	router.ImportRouter(importHandler, mux)
+
+	// Export resources
+	exportService := service.NewExportService(uow, validate)
+	exportHandler := handler.NewExportHandler(exportService)
+
+	// Export router
+	router.ExportRouter(exportHandler, mux)
+
+	// Search resources
+	searchService := service.NewSearchService(uow, validate)
+	searchHandler := handler.NewSearchHandler(searchService)
+
+	// Search router
+	router.SearchRouter(searchHandler, mux)
+
+	// Tag resources
+	tagService := service.NewTagService(uow, validate)
+	tagHandler := handler.NewTagHandler(tagService)
+
+	// Tag router
+	router.TagRouter(tagHandler, mux)
+
+	// Series resources
+	seriesService := service.NewSeriesService(uow, validate)
+	seriesHandler := handler.NewSeriesHandler(seriesService)
+
+	// Series router
+	router.SeriesRouter(seriesHandler, mux)
+
+	// Review resources
+	reviewService := service.NewReviewService(uow, validate)
+	reviewHandler := handler.NewReviewHandler(reviewService)
+
+	// Review router
+	router.ReviewRouter(reviewHandler, mux)
+
+	// Note resources
+	noteService := service.NewNoteService(uow, validate)
+	noteHandler := handler.NewNoteHandler(noteService)
+
+	// Note router
+	router.NoteRouter(noteHandler, mux)
+
+	// Highlight resources
+	highlightService := service.NewHighlightService(uow, validate)
+	highlightHandler := handler.NewHighlightHandler(highlightService)
+
+	// Highlight router
+	router.HighlightRouter(highlightHandler, mux)
+
+	// Goal resources
+	goalService := service.NewGoalService(uow, validate)
+	goalHandler := handler.NewGoalHandler(goalService)
+
+	// Goal router
+	router.GoalRouter(goalHandler, mux)
+
+	// Read resources
+	readService := service.NewReadService(uow, validate)
+	readHandler := handler.NewReadHandler(readService)
+
+	// Read router
+	router.ReadRouter(readHandler, mux)
+
+	// Stats resources
+	statsService := service.NewStatsService(uow, validate)
+	statsHandler := handler.NewStatsHandler(statsService)
+
+	// Stats router
+	router.StatsRouter(statsHandler, mux)
+
 	// Auth resources
-	authService := service.NewAuthService(uow, validate)
+	authService := service.NewAuthService(uow, validate, cfg)
 	authHandler := handler.NewAuthHandler(authService)
 
 	// Auth router
 	router.AuthRouter(authHandler, mux)
 
-	// Server
+	// Server, every /api/v1 route except register, login and refresh requires an access token
 	server := http.Server{
 		Addr:    ":" + cfg.AppPort,
-		Handler: mux,
+		Handler: middleware.AuthMiddleware(mux, cfg),
 	}
 
 	// Graceful shutdown
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"time"
@@ -11,8 +12,20 @@ import (
 type Environment string
 
 const (
-	EnvProduction  Environment   = "production"
-	ShutdownPeriod time.Duration = 10 * time.Second
+	EnvProduction      Environment   = "production"
+	ShutdownPeriod     time.Duration = 10 * time.Second
+	AccessTokenPeriod  time.Duration = 15 * time.Minute
+	RefreshTokenPeriod time.Duration = 7 * 24 * time.Hour
+	MetadataTimeout    time.Duration = 10 * time.Second
+
+	// MinAccessTokenSecretLength is the shortest HS256 key accepted, jwt signs and verifies with any key even an
+	// empty one
+	MinAccessTokenSecretLength = 32
+
+	MetadataProviderOpenLibrary = "openlibrary"
+	MetadataProviderFile        = "file"
+
+	defaultOpenLibraryURL = "https://openlibrary.org"
 )
 
 type Config struct {
@@ -26,6 +39,17 @@ type Config struct {
 	MinIOSecretAccessKey string
 
 	BookBucket string
+	// AuthorBucket holds author portraits, it falls back to BookBucket when AUTHOR_BUCKET is not set. Portraits are
+	// kept under domain.AuthorPhotoKeyPrefix so they never share a key with a book cover
+	AuthorBucket string
+
+	AccessTokenSecret string
+
+	AutoMigrate bool
+
+	MetadataProvider string
+	MetadataFile     string
+	OpenLibraryURL   string
 }
 
 func LoadConfig() (*Config, error) {
@@ -40,6 +64,27 @@ func LoadConfig() (*Config, error) {
 
 	slog.Info("env loaded successfully")
 
+	metadataProvider := os.Getenv("METADATA_PROVIDER")
+	if metadataProvider == "" {
+		metadataProvider = MetadataProviderOpenLibrary
+	}
+
+	bookBucket := os.Getenv("BOOK_BUCKET")
+	authorBucket := os.Getenv("AUTHOR_BUCKET")
+	if authorBucket == "" {
+		authorBucket = bookBucket
+	}
+
+	accessTokenSecret := os.Getenv("ACCESS_TOKEN_SECRET")
+	if len(accessTokenSecret) < MinAccessTokenSecretLength {
+		return &Config{}, fmt.Errorf("ACCESS_TOKEN_SECRET must be at least %d bytes", MinAccessTokenSecretLength)
+	}
+
+	openLibraryURL := os.Getenv("OPEN_LIBRARY_URL")
+	if openLibraryURL == "" {
+		openLibraryURL = defaultOpenLibraryURL
+	}
+
 	return &Config{
 		AppEnv:               appEnv,
 		DBURL:                os.Getenv("DB_URL"),
@@ -47,6 +92,12 @@ func LoadConfig() (*Config, error) {
 		MinIOEndpoint:        os.Getenv("MINIO_ENDPOINT"),
 		MinIOAccessKeyId:     os.Getenv("MINIO_ACCESS_KEY_ID"),
 		MinIOSecretAccessKey: os.Getenv("MINIO_SECRET_ACCESS_KEY"),
-		BookBucket:           os.Getenv("BOOK_BUCKET"),
+		BookBucket:           bookBucket,
+		AuthorBucket:         authorBucket,
+		AccessTokenSecret:    accessTokenSecret,
+		AutoMigrate:          os.Getenv("AUTO_MIGRATE") == "true",
+		MetadataProvider:     metadataProvider,
+		MetadataFile:         os.Getenv("METADATA_FILE"),
+		OpenLibraryURL:       openLibraryURL,
 	}, nil
 }
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadConfigAccessTokenSecret(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Secret    string
+		ExpectErr bool
+	}{
+		{
+			Name:      "missing secret",
+			Secret:    "",
+			ExpectErr: true,
+		},
+		{
+			Name:      "secret shorter than 32 bytes",
+			Secret:    strings.Repeat("s", MinAccessTokenSecretLength-1),
+			ExpectErr: true,
+		},
+		{
+			Name:      "secret of 32 bytes",
+			Secret:    strings.Repeat("s", MinAccessTokenSecretLength),
+			ExpectErr: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			// Production reads the environment only, no .env file is loaded
+			t.Setenv("APP_ENV", string(EnvProduction))
+			t.Setenv("ACCESS_TOKEN_SECRET", c.Secret)
+
+			cfg, err := LoadConfig()
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				if !strings.Contains(err.Error(), "ACCESS_TOKEN_SECRET") {
+					t.Errorf("expected the error to name ACCESS_TOKEN_SECRET but got '%v'", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got %v", err)
+			}
+			if cfg.AccessTokenSecret != c.Secret {
+				t.Errorf("expected the secret to be loaded but got '%s'", cfg.AccessTokenSecret)
+			}
+		})
+	}
+}
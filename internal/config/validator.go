@@ -1,21 +1,30 @@
 package config
 
 import (
+	"math"
 	"reflect"
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
 )
 
 var (
-	// Regex for name, only a-z, A-Z, ., ', and -
-	nameRegex = regexp.MustCompile(`^[a-zA-Z .'-]+$`)
+	// Regex for name, letters of any script with their combining marks, spaces, ., ', ’, -, and parentheses
+	// for a name in another script such as "Haruki Murakami (村上春樹)"
+	nameRegex = regexp.MustCompile(`^[\p{L}\p{M} .'’()-]+$`)
 
 	// Regex for password, min 8 chars, at least one uppercase and lowercase, and at least one digit
 	upperRe = regexp.MustCompile(`[A-Z]`)
 	lowerRe = regexp.MustCompile(`[a-z]`)
 	digitRe = regexp.MustCompile(`[0-9]`)
+
+	// Regex for external author identifiers, an Open Library author key such as OL23919A and a Wikidata item such as Q5879
+	openLibraryAuthorIdRegex = regexp.MustCompile(`^OL[1-9][0-9]*A$`)
+	wikidataIdRegex          = regexp.MustCompile(`^Q[1-9][0-9]*$`)
 )
 
 func ValidatorInit() *validator.Validate {
@@ -27,15 +36,23 @@ func ValidatorInit() *validator.Validate {
 	// Register custom validation
 	validate.RegisterValidation("validName", validName)
 	validate.RegisterValidation("bookStatus", bookStatus)
+	validate.RegisterValidation("contributorRole", contributorRole)
 	validate.RegisterValidation("validPhotoKey", validPhotoKey)
+	validate.RegisterValidation("authorPhotoKey", authorPhotoKey)
 	validate.RegisterValidation("validPassword", validPassword)
+	validate.RegisterValidation("validIsbn", validIsbn)
+	validate.RegisterValidation("validRating", validRating)
+	validate.RegisterValidation("noteType", noteType)
+	validate.RegisterValidation("openLibraryAuthorId", openLibraryAuthorId)
+	validate.RegisterValidation("wikidataId", wikidataId)
 
 	return validate
 }
 
+// validName accepts names in any script, a name made only of punctuation is not a name
 func validName(fl validator.FieldLevel) bool {
 	value := fl.Field().String()
-	return nameRegex.MatchString(value)
+	return nameRegex.MatchString(value) && strings.IndexFunc(value, unicode.IsLetter) != -1
 }
 
 func bookStatus(fl validator.FieldLevel) bool {
@@ -48,6 +65,24 @@ func bookStatus(fl validator.FieldLevel) bool {
 	return true
 }
 
+func contributorRole(fl validator.FieldLevel) bool {
+	switch fl.Field().String() {
+	case domain.ContributorRoleAuthor, domain.ContributorRoleCoAuthor, domain.ContributorRoleTranslator, domain.ContributorRoleIllustrator:
+		return true
+	}
+
+	return false
+}
+
+func noteType(fl validator.FieldLevel) bool {
+	switch fl.Field().String() {
+	case domain.NoteTypeNote, domain.NoteTypeHighlight, domain.NoteTypeQuote:
+		return true
+	}
+
+	return false
+}
+
 func validPhotoKey(fl validator.FieldLevel) bool {
 	value := fl.Field().String()
 
@@ -58,6 +93,11 @@ func validPhotoKey(fl validator.FieldLevel) bool {
 	return strings.HasSuffix(strings.ToLower(value), ".jpg")
 }
 
+// authorPhotoKey accepts only keys handed out for portraits, so an author never points to a book cover
+func authorPhotoKey(fl validator.FieldLevel) bool {
+	return strings.HasPrefix(fl.Field().String(), domain.AuthorPhotoKeyPrefix)
+}
+
 func validPassword(fl validator.FieldLevel) bool {
 	value := fl.Field().String()
 
@@ -76,3 +116,27 @@ func validPassword(fl validator.FieldLevel) bool {
 
 	return true
 }
+
+// validIsbn accepts ISBN-10 and ISBN-13 with a correct check digit, with or without hyphens
+func validIsbn(fl validator.FieldLevel) bool {
+	return helper.IsValidISBN(fl.Field().String())
+}
+
+// validRating accepts half star steps from 0.5 to 5
+func validRating(fl validator.FieldLevel) bool {
+	value := fl.Field().Float()
+
+	if value < 0.5 || value > 5 {
+		return false
+	}
+
+	return math.Mod(value*2, 1) == 0
+}
+
+func openLibraryAuthorId(fl validator.FieldLevel) bool {
+	return openLibraryAuthorIdRegex.MatchString(fl.Field().String())
+}
+
+func wikidataId(fl validator.FieldLevel) bool {
+	return wikidataIdRegex.MatchString(fl.Field().String())
+}
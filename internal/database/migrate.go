@@ -0,0 +1,337 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockKey is the pg_advisory_lock key held while migrating so concurrent replicas do not race
+const migrationLockKey int64 = 7_361_842_095
+
+// The schema_migrations layout matches golang-migrate, so databases migrated by that tool keep their version
+const createSchemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT NOT NULL PRIMARY KEY,
+    dirty BOOLEAN NOT NULL
+)`
+
+var ErrDirtyDatabase = errors.New("database is dirty, fix the failed migration manually and force its version")
+
+type Migration struct {
+	Version int64
+	Name    string
+	UpSQL   string
+	DownSQL string
+}
+
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+type Migrator struct {
+	db         *pgxpool.Pool
+	migrations []Migration
+}
+
+func NewMigrator(db *pgxpool.Pool) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up(ctx context.Context) error {
+	if len(m.migrations) == 0 {
+		return nil
+	}
+
+	return m.Goto(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// Down rolls back the given number of applied migrations
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		idx := m.indexOf(current)
+		if current != 0 && idx < 0 {
+			return fmt.Errorf("database version %d has no embedded migration", current)
+		}
+
+		for i := 0; i < steps && idx >= 0; i++ {
+			if err := m.apply(ctx, conn, idx, false); err != nil {
+				return err
+			}
+			idx--
+		}
+
+		return nil
+	})
+}
+
+// Goto migrates up or down until the database is at the given version, 0 rolls back everything
+func (m *Migrator) Goto(ctx context.Context, version int64) error {
+	if version != 0 && m.indexOf(version) < 0 {
+		return fmt.Errorf("migration version %d does not exist", version)
+	}
+
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		idx := m.indexOf(current)
+		if current != 0 && idx < 0 {
+			return fmt.Errorf("database version %d has no embedded migration", current)
+		}
+
+		// Up
+		for idx+1 < len(m.migrations) && m.migrations[idx+1].Version <= version {
+			idx++
+			if err := m.apply(ctx, conn, idx, true); err != nil {
+				return err
+			}
+		}
+
+		// Down
+		for idx >= 0 && m.migrations[idx].Version > version {
+			if err := m.apply(ctx, conn, idx, false); err != nil {
+				return err
+			}
+			idx--
+		}
+
+		return nil
+	})
+}
+
+// Status returns the current version, whether it is dirty and every embedded migration with its applied state
+func (m *Migrator) Status(ctx context.Context) (int64, bool, []MigrationStatus, error) {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return 0, false, nil, err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, createSchemaMigrationsTable); err != nil {
+		return 0, false, nil, err
+	}
+
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, false, nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		statuses = append(statuses, MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: migration.Version <= version,
+		})
+	}
+
+	return version, dirty, statuses, nil
+}
+
+// withLock runs fn on a single connection that holds the migration advisory lock,
+// session level advisory locks belong to a connection so the same one must be used for every statement
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			slog.Error("failed to release migration lock", "err", err)
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, createSchemaMigrationsTable); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) currentVersion(ctx context.Context, conn *pgxpool.Conn) (int64, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	if dirty {
+		return 0, fmt.Errorf("version %d: %w", version, ErrDirtyDatabase)
+	}
+
+	return version, nil
+}
+
+// apply runs a single migration and records the resulting version in the same transaction
+func (m *Migrator) apply(ctx context.Context, conn *pgxpool.Conn, idx int, up bool) error {
+	migration := m.migrations[idx]
+
+	query := migration.DownSQL
+	newVersion := int64(0)
+	direction := "down"
+	if idx > 0 {
+		newVersion = m.migrations[idx-1].Version
+	}
+	if up {
+		query = migration.UpSQL
+		newVersion = migration.Version
+		direction = "up"
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return fmt.Errorf("migration %d_%s %s failed: %w", migration.Version, migration.Name, direction, err)
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return err
+	}
+
+	if newVersion != 0 {
+		if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, FALSE)", newVersion); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	slog.Info("migration applied", "version", migration.Version, "name", migration.Name, "direction", direction)
+	return nil
+}
+
+func (m *Migrator) indexOf(version int64) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func readVersion(ctx context.Context, conn *pgxpool.Conn) (int64, bool, error) {
+	var version int64
+	var dirty bool
+
+	err := conn.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return version, dirty, nil
+}
+
+// loadMigrations reads files named <version>_<name>.up.sql and <version>_<name>.down.sql sorted by version
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		var up bool
+		var base string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			up = true
+			base = strings.TrimSuffix(fileName, ".up.sql")
+		case strings.HasSuffix(fileName, ".down.sql"):
+			base = strings.TrimSuffix(fileName, ".down.sql")
+		default:
+			continue
+		}
+
+		rawVersion, name, found := strings.Cut(base, "_")
+		if !found {
+			return nil, fmt.Errorf("invalid migration file name %q", fileName)
+		}
+
+		version, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", fileName)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration version %d is used by %q and %q", version, migration.Name, name)
+		}
+
+		if up {
+			migration.UpSQL = string(content)
+		} else {
+			migration.DownSQL = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.UpSQL == "" || migration.DownSQL == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("embedded migrations are complete and ordered", func(t *testing.T) {
+		migrations, err := loadMigrations(migrationFiles, "migrations")
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if len(migrations) == 0 {
+			t.Fatal("expected embedded migrations but got none")
+		}
+
+		for i, migration := range migrations {
+			if migration.Version != int64(i+1) {
+				t.Errorf("expected version %d but got %d", i+1, migration.Version)
+			}
+
+			if migration.UpSQL == "" || migration.DownSQL == "" {
+				t.Errorf("expected up and down sql for version %d", migration.Version)
+			}
+		}
+	})
+
+	t.Run("migrations are sorted by version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"migrations/000010_create_table_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+			"migrations/000010_create_table_b.down.sql": {Data: []byte("DROP TABLE b;")},
+			"migrations/000002_create_table_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+			"migrations/000002_create_table_a.down.sql": {Data: []byte("DROP TABLE a;")},
+			"migrations/README.md":                      {Data: []byte("ignored")},
+		}
+
+		migrations, err := loadMigrations(fsys, "migrations")
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if len(migrations) != 2 {
+			t.Fatalf("expected 2 migrations but got %d", len(migrations))
+		}
+
+		if migrations[0].Version != 2 || migrations[0].Name != "create_table_a" {
+			t.Errorf("expected 2_create_table_a first but got %d_%s", migrations[0].Version, migrations[0].Name)
+		}
+
+		if migrations[1].Version != 10 || migrations[1].DownSQL != "DROP TABLE b;" {
+			t.Errorf("expected 10_create_table_b second but got %d_%s", migrations[1].Version, migrations[1].Name)
+		}
+	})
+
+	t.Run("invalid migration files", func(t *testing.T) {
+		cases := []struct {
+			Name string
+			FS   fstest.MapFS
+		}{
+			{
+				Name: "missing down file",
+				FS: fstest.MapFS{
+					"migrations/000001_create_table_a.up.sql": {Data: []byte("CREATE TABLE a ();")},
+				},
+			},
+			{
+				Name: "invalid version",
+				FS: fstest.MapFS{
+					"migrations/first_create_table_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+					"migrations/first_create_table_a.down.sql": {Data: []byte("DROP TABLE a;")},
+				},
+			},
+			{
+				Name: "duplicate version",
+				FS: fstest.MapFS{
+					"migrations/000001_create_table_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+					"migrations/000001_create_table_a.down.sql": {Data: []byte("DROP TABLE a;")},
+					"migrations/000001_create_table_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+					"migrations/000001_create_table_b.down.sql": {Data: []byte("DROP TABLE b;")},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				_, err := loadMigrations(c.FS, "migrations")
+				if err == nil {
+					t.Error("expected an error but got nil")
+				}
+			})
+		}
+	})
+}
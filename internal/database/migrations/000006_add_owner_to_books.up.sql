@@ -0,0 +1,3 @@
+ALTER TABLE books ADD COLUMN owner_id UUID;
+ALTER TABLE books ADD FOREIGN KEY(owner_id) REFERENCES users (id) ON DELETE CASCADE;
+CREATE INDEX books_owner_id_idx ON books (owner_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS reading_sessions;
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_current_page_check;
+ALTER TABLE books DROP COLUMN IF EXISTS current_page;
@@ -0,0 +1,19 @@
+ALTER TABLE books ADD COLUMN current_page INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE books ADD CONSTRAINT books_current_page_check CHECK (current_page >= 0 AND current_page <= total_page);
+
+CREATE TABLE reading_sessions (
+    id UUID,
+    book_id UUID NOT NULL,
+    session_date DATE NOT NULL,
+    start_page INTEGER NOT NULL,
+    end_page INTEGER NOT NULL,
+    minutes_read INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    CHECK (start_page >= 0 AND start_page <= end_page)
+);
+
+CREATE INDEX reading_sessions_book_id_idx ON reading_sessions (book_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS authors_full_name_trgm_idx;
+DROP INDEX IF EXISTS authors_search_vector_idx;
+ALTER TABLE authors DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS books_name_trgm_idx;
+DROP INDEX IF EXISTS books_search_vector_idx;
+ALTER TABLE books DROP COLUMN IF EXISTS search_vector;
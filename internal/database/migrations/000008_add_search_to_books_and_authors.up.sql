@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE books ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (setweight(to_tsvector('english', coalesce(name, '')), 'A')) STORED;
+CREATE INDEX books_search_vector_idx ON books USING GIN (search_vector);
+CREATE INDEX books_name_trgm_idx ON books USING GIN (name gin_trgm_ops);
+
+ALTER TABLE authors ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(full_name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(nationality, '')), 'C')
+    ) STORED;
+CREATE INDEX authors_search_vector_idx ON authors USING GIN (search_vector);
+CREATE INDEX authors_full_name_trgm_idx ON authors USING GIN (full_name gin_trgm_ops);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS books_owner_id_isbn_idx;
+
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_publication_year_check;
+
+ALTER TABLE books DROP COLUMN IF EXISTS language;
+ALTER TABLE books DROP COLUMN IF EXISTS publication_year;
+ALTER TABLE books DROP COLUMN IF EXISTS publisher;
+ALTER TABLE books DROP COLUMN IF EXISTS isbn;
@@ -0,0 +1,8 @@
+ALTER TABLE books ADD COLUMN isbn VARCHAR(13) NOT NULL DEFAULT '';
+ALTER TABLE books ADD COLUMN publisher VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE books ADD COLUMN publication_year INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE books ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '';
+
+ALTER TABLE books ADD CONSTRAINT books_publication_year_check CHECK (publication_year >= 0);
+
+CREATE INDEX books_owner_id_isbn_idx ON books (owner_id, isbn) WHERE isbn <> '';
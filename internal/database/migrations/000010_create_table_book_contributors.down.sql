@@ -0,0 +1,18 @@
+ALTER TABLE books ADD COLUMN author_id UUID;
+
+-- Only the first contributor of a book fits in the single column
+UPDATE books b
+SET author_id = (
+    SELECT bc.author_id FROM book_contributors bc
+    WHERE bc.book_id = b.id
+    ORDER BY bc.position ASC
+    LIMIT 1
+);
+
+DELETE FROM books WHERE author_id IS NULL;
+
+ALTER TABLE books ALTER COLUMN author_id SET NOT NULL;
+ALTER TABLE books ADD FOREIGN KEY(author_id) REFERENCES authors (id);
+
+DROP TABLE IF EXISTS book_contributors;
+DROP TYPE IF EXISTS contributor_role;
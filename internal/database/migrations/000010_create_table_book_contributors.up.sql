@@ -0,0 +1,20 @@
+CREATE TYPE contributor_role AS ENUM ('author', 'co_author', 'translator', 'illustrator');
+
+CREATE TABLE book_contributors (
+    book_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    role contributor_role NOT NULL DEFAULT 'author',
+    position INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY(book_id, author_id, role),
+    UNIQUE(book_id, position),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    FOREIGN KEY(author_id) REFERENCES authors (id)
+);
+
+CREATE INDEX book_contributors_author_id_idx ON book_contributors (author_id);
+
+INSERT INTO book_contributors (book_id, author_id, role, position)
+SELECT id, author_id, 'author', 0 FROM books;
+
+ALTER TABLE books DROP COLUMN author_id;
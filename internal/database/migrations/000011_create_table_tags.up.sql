@@ -0,0 +1,25 @@
+CREATE TABLE tags (
+    id UUID,
+    owner_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(owner_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Tag names are unique per owner regardless of case, "Fantasy" and "fantasy" are the same tag
+CREATE UNIQUE INDEX tags_owner_id_name_idx ON tags (owner_id, LOWER(name));
+
+CREATE TABLE book_tags (
+    book_id UUID NOT NULL,
+    tag_id UUID NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(book_id, tag_id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    FOREIGN KEY(tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX book_tags_tag_id_idx ON book_tags (tag_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS books_series_id_idx;
+
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_series_position_check;
+ALTER TABLE books DROP COLUMN IF EXISTS series_position;
+ALTER TABLE books DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS series;
@@ -0,0 +1,21 @@
+CREATE TABLE series (
+    id UUID,
+    name VARCHAR(255) NOT NULL,
+    author_id UUID,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(author_id) REFERENCES authors (id) ON DELETE SET NULL
+);
+
+CREATE INDEX series_author_id_idx ON series (author_id);
+
+-- series_position is fractional so novellas can sit between volumes, such as 2.5 of The Expanse
+ALTER TABLE books ADD COLUMN series_id UUID;
+ALTER TABLE books ADD COLUMN series_position NUMERIC(6, 2);
+ALTER TABLE books ADD FOREIGN KEY(series_id) REFERENCES series (id);
+ALTER TABLE books ADD CONSTRAINT books_series_position_check
+    CHECK ((series_id IS NULL AND series_position IS NULL) OR (series_id IS NOT NULL AND series_position >= 0));
+
+CREATE INDEX books_series_id_idx ON books (series_id, series_position) WHERE series_id IS NOT NULL;
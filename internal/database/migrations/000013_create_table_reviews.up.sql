@@ -0,0 +1,31 @@
+-- A book has at most one current review, earlier versions are moved to review_revisions on every edit
+CREATE TABLE reviews (
+    id UUID,
+    book_id UUID NOT NULL,
+    rating NUMERIC(2, 1) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    UNIQUE(book_id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    -- Ratings go from half a star to five stars in half star steps
+    CONSTRAINT reviews_rating_check CHECK (rating BETWEEN 0.5 AND 5 AND rating * 2 = TRUNC(rating * 2))
+);
+
+CREATE INDEX reviews_rating_idx ON reviews (rating);
+
+-- created_at of a revision is when that version was written, the updated_at of the review it replaced
+CREATE TABLE review_revisions (
+    id UUID,
+    review_id UUID NOT NULL,
+    rating NUMERIC(2, 1) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(review_id) REFERENCES reviews (id) ON DELETE CASCADE
+);
+
+CREATE INDEX review_revisions_review_id_idx ON review_revisions (review_id, created_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS notes;
+DROP TYPE IF EXISTS note_type;
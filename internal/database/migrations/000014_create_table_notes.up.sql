@@ -0,0 +1,25 @@
+CREATE TYPE note_type AS ENUM ('note', 'highlight', 'quote');
+
+-- Notes are removed through NoteRepository when a book is deleted,
+-- the cascade still covers books that go away with their owner
+CREATE TABLE notes (
+    id UUID,
+    book_id UUID NOT NULL,
+    type note_type NOT NULL,
+    text TEXT NOT NULL,
+    page INTEGER,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    CHECK (page IS NULL OR page >= 1)
+);
+
+CREATE INDEX notes_book_id_idx ON notes (book_id, page);
+
+-- Note text is stemmed as English like book titles, trigrams let typos still match
+ALTER TABLE notes ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('english', text)) STORED;
+CREATE INDEX notes_search_vector_idx ON notes USING GIN (search_vector);
+CREATE INDEX notes_text_trgm_idx ON notes USING GIN (text gin_trgm_ops);
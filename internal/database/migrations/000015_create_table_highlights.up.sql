@@ -0,0 +1,19 @@
+-- Highlights are imported from e-reader clippings, fingerprint identifies a clipping
+-- so importing the same file again does not store it twice
+CREATE TABLE highlights (
+    id UUID,
+    book_id UUID NOT NULL,
+    type note_type NOT NULL,
+    text TEXT NOT NULL,
+    location VARCHAR(32),
+    page INTEGER,
+    clipped_at TIMESTAMP(0) WITHOUT TIME ZONE,
+    fingerprint CHAR(64) NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    UNIQUE(book_id, fingerprint),
+    CHECK (type IN ('note', 'highlight')),
+    CHECK (page IS NULL OR page >= 1)
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS reading_sessions_session_date_idx;
+DROP TABLE IF EXISTS goals;
+DROP TYPE IF EXISTS goal_period;
+DROP TYPE IF EXISTS goal_metric;
@@ -0,0 +1,23 @@
+CREATE TYPE goal_metric AS ENUM ('books', 'pages');
+CREATE TYPE goal_period AS ENUM ('year', 'quarter', 'month');
+
+-- start_date and end_date are derived from the period so progress queries only compare dates
+CREATE TABLE goals (
+    id UUID,
+    owner_id UUID NOT NULL,
+    metric goal_metric NOT NULL,
+    period goal_period NOT NULL,
+    target INTEGER NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(owner_id) REFERENCES users (id) ON DELETE CASCADE,
+    UNIQUE(owner_id, metric, period, start_date),
+    CHECK (target >= 1),
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX reading_sessions_session_date_idx ON reading_sessions (session_date);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS books_owner_id_completed_date_idx;
+
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_completed_date_check;
+
+ALTER TABLE books ALTER COLUMN completed_date TYPE VARCHAR(255) USING COALESCE(TO_CHAR(completed_date, 'YYYY-MM-DD'), '');
@@ -0,0 +1,23 @@
+-- completed_date was free text. YYYY-MM-DD and YYYY/MM/DD values are kept, anything that is not a real date is dropped
+CREATE FUNCTION pg_temp.parse_completed_date(value TEXT) RETURNS DATE AS $$
+BEGIN
+    IF value !~ '^\s*[0-9]{4}[-/][0-9]{1,2}[-/][0-9]{1,2}\s*$' THEN
+        RETURN NULL;
+    END IF;
+
+    RETURN to_date(replace(trim(value), '/', '-'), 'YYYY-MM-DD');
+EXCEPTION WHEN others THEN
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE books ALTER COLUMN completed_date TYPE DATE USING pg_temp.parse_completed_date(completed_date);
+
+-- Only completed books keep a date, a completed book without a usable date falls back to the day it was added,
+-- the same fallback the shelf import uses for books without a date read
+UPDATE books SET completed_date = NULL WHERE status <> 'completed' AND completed_date IS NOT NULL;
+UPDATE books SET completed_date = created_at::date WHERE status = 'completed' AND completed_date IS NULL;
+
+ALTER TABLE books ADD CONSTRAINT books_completed_date_check CHECK ((status = 'completed') = (completed_date IS NOT NULL));
+
+CREATE INDEX books_owner_id_completed_date_idx ON books (owner_id, completed_date) WHERE completed_date IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS reads_book_id_in_progress_idx;
+DROP INDEX IF EXISTS reads_finished_date_idx;
+DROP INDEX IF EXISTS reads_book_id_idx;
+DROP TABLE IF EXISTS reads;
+DROP TYPE IF EXISTS read_outcome;
@@ -0,0 +1,40 @@
+CREATE TYPE read_outcome AS ENUM ('finished', 'abandoned');
+
+-- A read in progress has no outcome and no finished_date, status and completed_date of a book follow its latest read
+CREATE TABLE reads (
+    id UUID,
+    book_id UUID NOT NULL,
+    started_date DATE NOT NULL,
+    finished_date DATE,
+    outcome read_outcome,
+    rating NUMERIC(2, 1),
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(book_id) REFERENCES books (id) ON DELETE CASCADE,
+    CHECK ((outcome IS NULL) = (finished_date IS NULL)),
+    CHECK (finished_date >= started_date),
+    CONSTRAINT reads_rating_check CHECK (rating BETWEEN 0.5 AND 5 AND rating * 2 = TRUNC(rating * 2))
+);
+
+CREATE INDEX reads_book_id_idx ON reads (book_id, started_date DESC);
+CREATE INDEX reads_finished_date_idx ON reads (finished_date) WHERE outcome = 'finished';
+
+-- A book has at most one read in progress
+CREATE UNIQUE INDEX reads_book_id_in_progress_idx ON reads (book_id) WHERE outcome IS NULL;
+
+-- Every completed book has been read once and every book being read has a read in progress,
+-- a read starts on the first logged session when there is one
+INSERT INTO reads (id, book_id, started_date, finished_date, outcome)
+SELECT gen_random_uuid(), b.id,
+       LEAST(COALESCE((SELECT MIN(rs.session_date) FROM reading_sessions rs WHERE rs.book_id = b.id), b.completed_date), b.completed_date),
+       b.completed_date, 'finished'
+FROM books b
+WHERE b.status = 'completed';
+
+INSERT INTO reads (id, book_id, started_date)
+SELECT gen_random_uuid(), b.id,
+       COALESCE((SELECT MIN(rs.session_date) FROM reading_sessions rs WHERE rs.book_id = b.id), b.created_at::date)
+FROM books b
+WHERE b.status = 'reading';
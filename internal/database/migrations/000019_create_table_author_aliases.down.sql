@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS author_aliases_author_id_idx;
+DROP TABLE IF EXISTS author_aliases;
@@ -0,0 +1,15 @@
+-- Other spellings of an author, such as the names of the authors merged into it. An alias is unique
+-- across every author like full_name, so a name always points to a single author
+CREATE TABLE author_aliases (
+    id UUID,
+    author_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP(0) WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(author_id) REFERENCES authors (id) ON DELETE CASCADE,
+    UNIQUE(name)
+);
+
+CREATE INDEX author_aliases_author_id_idx ON author_aliases (author_id);
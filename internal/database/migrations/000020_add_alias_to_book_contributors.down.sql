@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS author_aliases_name_trgm_idx;
+DROP INDEX IF EXISTS book_contributors_alias_id_idx;
+ALTER TABLE book_contributors DROP COLUMN IF EXISTS alias_id;
@@ -0,0 +1,8 @@
+-- alias_id is the name the contributor was published under, the contribution stays when the alias is deleted
+ALTER TABLE book_contributors ADD COLUMN alias_id UUID;
+ALTER TABLE book_contributors ADD FOREIGN KEY(alias_id) REFERENCES author_aliases (id) ON DELETE SET NULL;
+
+CREATE INDEX book_contributors_alias_id_idx ON book_contributors (alias_id) WHERE alias_id IS NOT NULL;
+
+-- Author name filters and search match aliases the same way they match full_name
+CREATE INDEX author_aliases_name_trgm_idx ON author_aliases USING GIN (name gin_trgm_ops);
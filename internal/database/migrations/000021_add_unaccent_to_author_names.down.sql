@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS author_aliases_name_unaccent_trgm_idx;
+DROP INDEX IF EXISTS authors_full_name_unaccent_trgm_idx;
+DROP FUNCTION IF EXISTS immutable_unaccent(TEXT);
+DROP EXTENSION IF EXISTS unaccent;
@@ -0,0 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent is only STABLE because its dictionary can change, pinning the dictionary makes it usable in indexes
+CREATE OR REPLACE FUNCTION immutable_unaccent(TEXT) RETURNS TEXT
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+    AS $$ SELECT public.unaccent('public.unaccent'::regdictionary, $1) $$;
+
+-- Author name filters ignore accents, so "garcia marquez" finds "Gabriel García Márquez"
+CREATE INDEX authors_full_name_unaccent_trgm_idx ON authors USING GIN (immutable_unaccent(full_name) gin_trgm_ops);
+CREATE INDEX author_aliases_name_unaccent_trgm_idx ON author_aliases USING GIN (immutable_unaccent(name) gin_trgm_ops);
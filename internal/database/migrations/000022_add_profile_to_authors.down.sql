@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS authors_wikidata_id_idx;
+DROP INDEX IF EXISTS authors_open_library_id_idx;
+
+ALTER TABLE authors DROP CONSTRAINT IF EXISTS authors_life_dates_check;
+
+ALTER TABLE authors DROP COLUMN IF EXISTS photo_key;
+ALTER TABLE authors DROP COLUMN IF EXISTS wikidata_id;
+ALTER TABLE authors DROP COLUMN IF EXISTS open_library_id;
+ALTER TABLE authors DROP COLUMN IF EXISTS website;
+ALTER TABLE authors DROP COLUMN IF EXISTS bio;
+ALTER TABLE authors DROP COLUMN IF EXISTS death_date;
+ALTER TABLE authors DROP COLUMN IF EXISTS birth_date;
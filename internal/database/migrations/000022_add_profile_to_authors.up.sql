@@ -0,0 +1,13 @@
+ALTER TABLE authors ADD COLUMN birth_date DATE;
+ALTER TABLE authors ADD COLUMN death_date DATE;
+ALTER TABLE authors ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE authors ADD COLUMN website VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE authors ADD COLUMN open_library_id VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE authors ADD COLUMN wikidata_id VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE authors ADD COLUMN photo_key VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE authors ADD CONSTRAINT authors_life_dates_check CHECK (death_date IS NULL OR birth_date IS NULL OR death_date >= birth_date);
+
+-- An external identifier points to a single author, two authors with the same one are duplicates to merge
+CREATE UNIQUE INDEX authors_open_library_id_idx ON authors (open_library_id) WHERE open_library_id <> '';
+CREATE UNIQUE INDEX authors_wikidata_id_idx ON authors (wikidata_id) WHERE wikidata_id <> '';
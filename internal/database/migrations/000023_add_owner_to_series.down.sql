@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS series_owner_id_idx;
+
+ALTER TABLE series DROP COLUMN IF EXISTS owner_id;
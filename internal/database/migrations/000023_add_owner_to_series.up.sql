@@ -0,0 +1,33 @@
+-- A series belongs to the user who created it, like tags.
+-- A series is given to the owner of its books, a series whose books belong to several owners
+-- is copied for every other owner and their books are moved to the copy
+ALTER TABLE series ADD COLUMN owner_id UUID;
+ALTER TABLE series ADD COLUMN copied_from UUID;
+
+UPDATE series s
+SET owner_id = (
+    SELECT b.owner_id FROM books b
+    WHERE b.series_id = s.id
+    ORDER BY b.created_at, b.id
+    LIMIT 1
+);
+
+INSERT INTO series (id, name, author_id, owner_id, copied_from, created_at, updated_at)
+SELECT gen_random_uuid(), s.name, s.author_id, o.owner_id, s.id, s.created_at, s.updated_at
+FROM series s
+JOIN (SELECT DISTINCT series_id, owner_id FROM books WHERE series_id IS NOT NULL) o ON o.series_id = s.id
+WHERE o.owner_id <> s.owner_id;
+
+UPDATE books b
+SET series_id = c.id
+FROM series c
+WHERE c.copied_from = b.series_id AND c.owner_id = b.owner_id;
+
+-- Nobody can be told apart as the creator of a series without books
+DELETE FROM series WHERE owner_id IS NULL;
+
+ALTER TABLE series DROP COLUMN copied_from;
+ALTER TABLE series ALTER COLUMN owner_id SET NOT NULL;
+ALTER TABLE series ADD FOREIGN KEY(owner_id) REFERENCES users (id) ON DELETE CASCADE;
+
+CREATE INDEX series_owner_id_idx ON series (owner_id);
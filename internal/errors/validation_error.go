@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -14,8 +15,14 @@ func TranslateValidationErrors(err error) []map[string]string {
 		for _, e := range validateErrs {
 			msg := ""
 			switch e.Tag() {
-			case "required":
+			case "required", "required_with", "required_without", "required_if":
 				msg = fmt.Sprintf("%s is required", e.Field())
+			case "excluded_without":
+				msg = fmt.Sprintf("%s must not be given on its own", e.Field())
+			case "excluded_with":
+				msg = fmt.Sprintf("%s must not be given with %s", e.Field(), strings.ToLower(e.Param()))
+			case "excluded_unless":
+				msg = fmt.Sprintf("%s must not be given for this %s", e.Field(), strings.ToLower(strings.Fields(e.Param())[0]))
 			case "min":
 				msg = fmt.Sprintf("%s must be at least %s characters", e.Field(), e.Param())
 			case "max":
@@ -28,10 +35,32 @@ func TranslateValidationErrors(err error) []map[string]string {
 				msg = fmt.Sprintf("'%s' is not a valid UUID", e.Value())
 			case "bookStatus":
 				msg = "the valid value for this field are only 'completed', 'reading', and 'plan_to_read'"
+			case "contributorRole":
+				msg = "the valid value for this field are only 'author', 'co_author', 'translator', and 'illustrator'"
+			case "noteType":
+				msg = "the valid value for this field are only 'note', 'highlight', and 'quote'"
 			case "datetime":
 				msg = "use YYYY-MM-DD for valid datetime"
 			case "validPhotoKey":
 				msg = fmt.Sprintf("'%s' is not a valid photo key", e.Value())
+			case "authorPhotoKey":
+				msg = fmt.Sprintf("'%s' is not a portrait key, upload it through the author presigned URL", e.Value())
+			case "unique":
+				msg = fmt.Sprintf("%s must not contain the same value twice", e.Field())
+			case "oneof":
+				msg = fmt.Sprintf("%s must be one of '%s'", e.Field(), strings.Join(strings.Fields(e.Param()), "', '"))
+			case "validIsbn":
+				msg = fmt.Sprintf("'%s' is not a valid ISBN-10 or ISBN-13", e.Value())
+			case "bcp47_language_tag":
+				msg = fmt.Sprintf("%s must be a language tag such as 'en' or 'pt-BR'", e.Field())
+			case "validRating":
+				msg = fmt.Sprintf("%s must be between 0.5 and 5 in steps of 0.5", e.Field())
+			case "openLibraryAuthorId":
+				msg = fmt.Sprintf("'%s' is not a valid Open Library author ID such as 'OL23919A'", e.Value())
+			case "wikidataId":
+				msg = fmt.Sprintf("'%s' is not a valid Wikidata ID such as 'Q5879'", e.Value())
+			case "http_url":
+				msg = fmt.Sprintf("%s must be an http or https URL", e.Field())
 			case "validPassword":
 				msg = fmt.Sprintf("%s must contain at least one uppercase, one lowercase, and one digit", e.Field())
 			default:
@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"log/slog"
 	"net/http"
 
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
 	"github.com/mhaatha/go-bookshelf/internal/service"
 )
 
@@ -16,6 +20,119 @@ type AuthHandlerImpl struct {
 	AuthService service.AuthService
 }
 
-func (handler *AuthHandlerImpl) Register(w http.ResponseWriter, r *http.Request) {}
+func (handler *AuthHandlerImpl) Register(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to userRequest
+	userRequest := web.CreateUserRequest{}
+	err := helper.ReadFromRequestBody(r, &userRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	userResponse, err := handler.AuthService.CreateNewUser(r.Context(), userRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to register new user")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "User registered successfully",
+		Data:    userResponse,
+	})
+}
+
+func (handler *AuthHandlerImpl) Login(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to loginRequest
+	loginRequest := web.LoginRequest{}
+	err := helper.ReadFromRequestBody(r, &loginRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	loginResponse, err := handler.AuthService.LoginExistingUser(r.Context(), loginRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to login user")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Login successfully",
+		Data:    loginResponse,
+	})
+}
 
-func (handler *AuthHandlerImpl) Login(w http.ResponseWriter, r *http.Request) {}
+func (handler *AuthHandlerImpl) Refresh(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to refreshRequest
+	refreshRequest := web.RefreshTokenRequest{}
+	err := helper.ReadFromRequestBody(r, &refreshRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	refreshResponse, err := handler.AuthService.RefreshSession(r.Context(), refreshRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to refresh session")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Session refreshed successfully",
+		Data:    refreshResponse,
+	})
+}
+
+func (handler *AuthHandlerImpl) Logout(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to logoutRequest
+	logoutRequest := web.LogoutRequest{}
+	err := helper.ReadFromRequestBody(r, &logoutRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	err = handler.AuthService.Logout(r.Context(), logoutRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to logout user")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
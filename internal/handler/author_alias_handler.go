@@ -0,0 +1,11 @@
+package handler
+
+import "net/http"
+
+type AuthorAliasHandler interface {
+	Create(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetById(w http.ResponseWriter, r *http.Request)
+	UpdateById(w http.ResponseWriter, r *http.Request)
+	DeleteById(w http.ResponseWriter, r *http.Request)
+}
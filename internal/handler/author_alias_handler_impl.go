@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const wildcardAliasId = "alias_id"
+
+func NewAuthorAliasHandler(authorAliasService service.AuthorAliasService) AuthorAliasHandler {
+	return &AuthorAliasHandlerImpl{
+		AuthorAliasService: authorAliasService,
+	}
+}
+
+type AuthorAliasHandlerImpl struct {
+	AuthorAliasService service.AuthorAliasService
+}
+
+func (handler *AuthorAliasHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsAuthorAliases{
+		AuthorId: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to aliasRequest
+	aliasRequest := web.CreateAuthorAliasRequest{}
+	err := helper.ReadFromRequestBody(r, &aliasRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	aliasResponse, err := handler.AuthorAliasService.CreateNewAlias(r.Context(), pathValue, aliasRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new alias")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Alias created successfully",
+		Data:    aliasResponse,
+	})
+}
+
+func (handler *AuthorAliasHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsAuthorAliases{
+		AuthorId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	aliasResponse, err := handler.AuthorAliasService.GetAllAliases(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get aliases")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all aliases",
+		Data:    aliasResponse,
+	})
+}
+
+func (handler *AuthorAliasHandlerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsAuthorAlias{
+		AuthorId: r.PathValue(wildcardId),
+		AliasId:  r.PathValue(wildcardAliasId),
+	}
+
+	// Call the service
+	aliasResponse, err := handler.AuthorAliasService.GetAliasById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get alias by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get alias",
+		Data:    aliasResponse,
+	})
+}
+
+func (handler *AuthorAliasHandlerImpl) UpdateById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsAuthorAlias{
+		AuthorId: r.PathValue(wildcardId),
+		AliasId:  r.PathValue(wildcardAliasId),
+	}
+
+	// Get request body and write it to aliasRequest
+	aliasRequest := web.UpdateAuthorAliasRequest{}
+	err := helper.ReadFromRequestBody(r, &aliasRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	aliasResponse, err := handler.AuthorAliasService.UpdateAliasById(r.Context(), pathValue, aliasRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to update alias by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Alias updated successfully",
+		Data:    aliasResponse,
+	})
+}
+
+func (handler *AuthorAliasHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsAuthorAlias{
+		AuthorId: r.PathValue(wildcardId),
+		AliasId:  r.PathValue(wildcardAliasId),
+	}
+
+	// Call the service
+	err := handler.AuthorAliasService.DeleteAliasById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete alias by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockAuthorAliasService struct {
+	// CreateNewAlias and GetAllAliases
+	MockAuthorPathValue web.PathParamsAuthorAliases
+	CreateMockRequest   web.CreateAuthorAliasRequest
+
+	// GetAliasById, UpdateAliasById and DeleteAliasById
+	MockAliasPathValue web.PathParamsAuthorAlias
+	UpdateMockRequest  web.UpdateAuthorAliasRequest
+
+	MockResponse        web.AuthorAliasResponse
+	MockAliasesResponse []web.AuthorAliasResponse
+
+	MockError error
+}
+
+func (m *MockAuthorAliasService) CreateNewAlias(ctx context.Context, pathValues web.PathParamsAuthorAliases, request web.CreateAuthorAliasRequest) (web.AuthorAliasResponse, error) {
+	m.MockAuthorPathValue = pathValues
+	m.CreateMockRequest = request
+
+	if m.MockError != nil {
+		return web.AuthorAliasResponse{}, m.MockError
+	}
+
+	return m.MockResponse, nil
+}
+
+func (m *MockAuthorAliasService) GetAllAliases(ctx context.Context, pathValues web.PathParamsAuthorAliases) ([]web.AuthorAliasResponse, error) {
+	m.MockAuthorPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.MockAliasesResponse, nil
+}
+
+func (m *MockAuthorAliasService) GetAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) (web.AuthorAliasResponse, error) {
+	m.MockAliasPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.AuthorAliasResponse{}, m.MockError
+	}
+
+	return m.MockResponse, nil
+}
+
+func (m *MockAuthorAliasService) UpdateAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias, request web.UpdateAuthorAliasRequest) (web.AuthorAliasResponse, error) {
+	m.MockAliasPathValue = pathValues
+	m.UpdateMockRequest = request
+
+	if m.MockError != nil {
+		return web.AuthorAliasResponse{}, m.MockError
+	}
+
+	return m.MockResponse, nil
+}
+
+func (m *MockAuthorAliasService) DeleteAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) error {
+	m.MockAliasPathValue = pathValues
+
+	if m.MockError != nil {
+		return m.MockError
+	}
+
+	return nil
+}
+
+func TestAuthorAliasCreateHandler(t *testing.T) {
+	t.Run("create alias with valid request", func(t *testing.T) {
+		aliasRequest := web.CreateAuthorAliasRequest{
+			Name: "Richard Bachman",
+		}
+		expectedServiceResponse := web.AuthorAliasResponse{
+			Id:       "5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e",
+			AuthorId: "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+			Name:     "Richard Bachman",
+		}
+
+		mockService := &MockAuthorAliasService{
+			MockResponse: expectedServiceResponse,
+		}
+
+		handler := NewAuthorAliasHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases", ToJSON(aliasRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["name"] != expectedServiceResponse.Name {
+				t.Errorf("expected name '%s' but got '%s'", expectedServiceResponse.Name, val["name"])
+			}
+
+			if val["author_id"] != expectedServiceResponse.AuthorId {
+				t.Errorf("expected author_id '%s' but got '%s'", expectedServiceResponse.AuthorId, val["author_id"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path value and request body that has been passed to service
+		if mockService.MockAuthorPathValue.AuthorId != "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a" {
+			t.Errorf("expected author id 'd3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a' but got '%s'", mockService.MockAuthorPathValue.AuthorId)
+		}
+
+		if !reflect.DeepEqual(mockService.CreateMockRequest, aliasRequest) {
+			t.Errorf("expected %+v as request body but got %+v", aliasRequest, mockService.CreateMockRequest)
+		}
+	})
+
+	t.Run("create alias that is already taken", func(t *testing.T) {
+		mockService := &MockAuthorAliasService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "name",
+						Message: "author or alias Stephen King is already exists",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewAuthorAliasHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases", ToJSON(web.CreateAuthorAliasRequest{Name: "Stephen King"}))
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+
+	t.Run("create alias with invalid request", func(t *testing.T) {
+		cases := []struct {
+			Name         string
+			AliasRequest web.CreateAuthorAliasRequest
+			ErrMessage   string
+		}{
+			{
+				Name:         "name is missing",
+				AliasRequest: web.CreateAuthorAliasRequest{},
+				ErrMessage:   "name is required",
+			},
+			{
+				Name:         "name is too short",
+				AliasRequest: web.CreateAuthorAliasRequest{Name: "RB"},
+				ErrMessage:   "name must be at least 3 characters",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockAuthorAliasService{
+					MockError: validate.Struct(c.AliasRequest),
+				}
+
+				handler := NewAuthorAliasHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases", ToJSON(c.AliasRequest))
+				res := httptest.NewRecorder()
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != "name" {
+							t.Errorf("expected error field is name but got %s", val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestAuthorAliasGetAllHandler(t *testing.T) {
+	t.Run("get all aliases of an author", func(t *testing.T) {
+		mockService := &MockAuthorAliasService{
+			MockAliasesResponse: []web.AuthorAliasResponse{
+				{
+					Id:       "5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e",
+					AuthorId: "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+					Name:     "John Swithen",
+				},
+				{
+					Id:       "6c1d2e3f-4a5b-4c6d-9e7f-8a9b0c1d2e3f",
+					AuthorId: "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+					Name:     "Richard Bachman",
+				},
+			},
+		}
+
+		handler := NewAuthorAliasHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases", nil)
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		aliases, ok := actualResponseBody.Data.([]interface{})
+		if !ok {
+			t.Fatal("aliases should be true but got false")
+		}
+
+		if len(aliases) != 2 {
+			t.Errorf("expected 2 aliases but got %d", len(aliases))
+		}
+	})
+}
+
+func TestAuthorAliasDeleteHandler(t *testing.T) {
+	t.Run("delete alias", func(t *testing.T) {
+		expectedPathValue := web.PathParamsAuthorAlias{
+			AuthorId: "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+			AliasId:  "5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e",
+		}
+
+		mockService := &MockAuthorAliasService{}
+
+		handler := NewAuthorAliasHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases/5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e", nil)
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", expectedPathValue.AuthorId)
+		req.SetPathValue("alias_id", expectedPathValue.AliasId)
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNoContent {
+			t.Errorf("expected status code of %d but got %d", http.StatusNoContent, res.Code)
+		}
+
+		// Check actual path values that has been passed to service
+		if !reflect.DeepEqual(mockService.MockAliasPathValue, expectedPathValue) {
+			t.Errorf("expected %+v as path value but got %+v", expectedPathValue, mockService.MockAliasPathValue)
+		}
+	})
+
+	t.Run("delete alias with not found id", func(t *testing.T) {
+		mockService := &MockAuthorAliasService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "alias_id",
+						Message: "alias with id '5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e' is not found",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewAuthorAliasHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/aliases/5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e", nil)
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+		req.SetPathValue("alias_id", "5b0c1d2e-3f4a-4b5c-8d6e-7f8a9b0c1d2e")
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
@@ -8,4 +8,5 @@ type AuthorHandler interface {
 	GetById(w http.ResponseWriter, r *http.Request)
 	UpdateById(w http.ResponseWriter, r *http.Request)
 	DeleteById(w http.ResponseWriter, r *http.Request)
+	Merge(w http.ResponseWriter, r *http.Request)
 }
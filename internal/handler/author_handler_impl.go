@@ -13,6 +13,8 @@ import (
 const (
 	queryFullName    = "full_name"
 	queryNationality = "nationality"
+	queryMode        = "mode"
+	queryReassignTo  = "reassign_to"
 
 	wildcardId = "id"
 )
@@ -58,14 +60,30 @@ func (handler *AuthorHandlerImpl) Create(w http.ResponseWriter, r *http.Request)
 }
 
 func (handler *AuthorHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get authors")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get authors")
+		return
+	}
+
 	// Get query params if any
 	queries := web.QueryParamsGetAuthors{
 		FullName:    r.URL.Query().Get(queryFullName),
 		Nationality: r.URL.Query().Get(queryNationality),
+		Page:        page,
+		PageSize:    pageSize,
+		Sort:        r.URL.Query().Get(querySort),
 	}
 
 	// Call the service
-	authorsResponse, err := handler.AuthorService.GetAllAuthors(r.Context(), queries)
+	authorsResponse, meta, err := handler.AuthorService.GetAllAuthors(r.Context(), queries)
 	if err != nil {
 		appError.ResponseServiceErrorHandler(w, err, "failed to get authors")
 		return
@@ -82,6 +100,7 @@ func (handler *AuthorHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request)
 	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
 		Message: "Success get all authors",
 		Data:    authorsResponse,
+		Meta:    meta,
 	})
 }
 
@@ -153,8 +172,14 @@ func (handler *AuthorHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Requ
 		Id: r.PathValue(wildcardId),
 	}
 
+	// Get query params if any
+	queries := web.QueryParamsDeleteAuthor{
+		Mode:       r.URL.Query().Get(queryMode),
+		ReassignTo: r.URL.Query().Get(queryReassignTo),
+	}
+
 	// Call the service
-	err := handler.AuthorService.DeleteAuthorById(r.Context(), pathValue)
+	err := handler.AuthorService.DeleteAuthorById(r.Context(), pathValue, queries)
 	if err != nil {
 		appError.ResponseServiceErrorHandler(w, err, "failed to delete author by id")
 		return
@@ -170,3 +195,38 @@ func (handler *AuthorHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Requ
 	// Set to 204 No Content
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (handler *AuthorHandlerImpl) Merge(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsMergeAuthors{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to mergeRequest
+	mergeRequest := web.MergeAuthorsRequest{}
+	err := helper.ReadFromRequestBody(r, &mergeRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	authorResponse, err := handler.AuthorService.MergeAuthors(r.Context(), pathValue, mergeRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to merge authors")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Authors merged successfully",
+		Data:    authorResponse,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -26,6 +27,7 @@ type MockAuthorService struct {
 	// GetAllAuthors
 	GetAllCalledWithQuery web.QueryParamsGetAuthors
 	MockGetAllResponse    []web.GetAuthorResponse
+	MockGetAllMeta        web.PaginationMeta
 
 	// GetAuthorById
 	GetByIdCalledWithPathValue web.PathParamsGetAuthor
@@ -36,8 +38,14 @@ type MockAuthorService struct {
 	UpdateByIdCalledWithPathValue web.PathParamsUpdateAuthor
 	MockUpdateByIdResponse        web.UpdateAuthorResponse
 
+	// MergeAuthors
+	MergeCalledWithPathValue web.PathParamsMergeAuthors
+	MergeCalledWithRequest   web.MergeAuthorsRequest
+	MockMergeResponse        web.MergeAuthorsResponse
+
 	// DeleteAuthorById
 	DeleteByIdCalledWithPathValue web.PathParamsDeleteAuthor
+	DeleteByIdCalledWithQuery     web.QueryParamsDeleteAuthor
 
 	MockError error
 }
@@ -52,14 +60,14 @@ func (m *MockAuthorService) CreateNewAuthor(ctx context.Context, request web.Cre
 	return m.MockCreateResponse, nil
 }
 
-func (m *MockAuthorService) GetAllAuthors(ctx context.Context, queris web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, error) {
+func (m *MockAuthorService) GetAllAuthors(ctx context.Context, queris web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, web.PaginationMeta, error) {
 	m.GetAllCalledWithQuery = queris
 
 	if m.MockError != nil {
-		return m.MockGetAllResponse, m.MockError
+		return m.MockGetAllResponse, web.PaginationMeta{}, m.MockError
 	}
 
-	return m.MockGetAllResponse, nil
+	return m.MockGetAllResponse, m.MockGetAllMeta, nil
 }
 
 func (m *MockAuthorService) GetAuthorById(ctx context.Context, pathValues web.PathParamsGetAuthor) (web.GetAuthorResponse, error) {
@@ -83,8 +91,20 @@ func (m *MockAuthorService) UpdateAuthorById(ctx context.Context, pathValues web
 	return m.MockUpdateByIdResponse, nil
 }
 
-func (m *MockAuthorService) DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor) error {
+func (m *MockAuthorService) MergeAuthors(ctx context.Context, pathValues web.PathParamsMergeAuthors, request web.MergeAuthorsRequest) (web.MergeAuthorsResponse, error) {
+	m.MergeCalledWithPathValue = pathValues
+	m.MergeCalledWithRequest = request
+
+	if m.MockError != nil {
+		return m.MockMergeResponse, m.MockError
+	}
+
+	return m.MockMergeResponse, nil
+}
+
+func (m *MockAuthorService) DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor, queries web.QueryParamsDeleteAuthor) error {
 	m.DeleteByIdCalledWithPathValue = pathValues
+	m.DeleteByIdCalledWithQuery = queries
 
 	if m.MockError != nil {
 		return m.MockError
@@ -199,6 +219,15 @@ func TestAuthorCreateHandler(t *testing.T) {
 				ErrField:   "full_name",
 				ErrMessage: "full_name must not contain numbers or symbols",
 			},
+			{
+				Name: "letters required",
+				AuthorRequest: web.CreateAuthorRequest{
+					FullName:    "... - ...",
+					Nationality: "Indonesia",
+				},
+				ErrField:   "full_name",
+				ErrMessage: "full_name must not contain numbers or symbols",
+			},
 		}
 
 		validate := config.ValidatorInit()
@@ -251,6 +280,21 @@ func TestAuthorCreateHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("create author with a name in any script", func(t *testing.T) {
+		validate := config.ValidatorInit()
+
+		for _, authorRequest := range []web.CreateAuthorRequest{
+			{FullName: "Gabriel García Márquez", Nationality: "Colombia"},
+			{FullName: "Haruki Murakami (村上春樹)", Nationality: "Japan"},
+			{FullName: "Ngũgĩ wa Thiong’o", Nationality: "Kenya"},
+			{FullName: "Ahmadou Kourouma", Nationality: "Côte d'Ivoire"},
+		} {
+			if err := validate.Struct(authorRequest); err != nil {
+				t.Errorf("expected %+v to be valid but got %v", authorRequest, err)
+			}
+		}
+	})
+
 	t.Run("create author with invalid nationality", func(t *testing.T) {
 		cases := []struct {
 			Name          string
@@ -285,7 +329,7 @@ func TestAuthorCreateHandler(t *testing.T) {
 				ErrMessage: "nationality is required",
 			},
 			{
-				Name: "alpha only",
+				Name: "valid nationality",
 				AuthorRequest: web.CreateAuthorRequest{
 					FullName:    "Leila S. Chudori",
 					Nationality: "Invalid Nationality Name #123",
@@ -403,6 +447,239 @@ func TestAuthorCreateHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("create author with a profile", func(t *testing.T) {
+		authorRequest := web.CreateAuthorRequest{
+			FullName:      "Gabriel García Márquez",
+			Nationality:   "Colombia",
+			BirthDate:     "1927-03-06",
+			DeathDate:     "2014-04-17",
+			Bio:           "Author of *One Hundred Years of Solitude*.",
+			Website:       "https://www.nobelprize.org/prizes/literature/1982/marquez/facts/",
+			OpenLibraryId: "OL4586796A",
+			WikidataId:    "Q5878",
+			PhotoKey:      "authors/9b2c7e41-5d0a-4f3e-8c61-2a7d4e9f0b13.jpg",
+		}
+
+		if err := config.ValidatorInit().Struct(authorRequest); err != nil {
+			t.Fatalf("expected a valid profile but got %v", err)
+		}
+
+		expectedServiceResponse := web.CreateAuthorResponse{
+			Id:            "f3a1c2d4-6b7e-4f80-9a1b-2c3d4e5f6a7b",
+			FullName:      authorRequest.FullName,
+			Nationality:   authorRequest.Nationality,
+			BirthDate:     authorRequest.BirthDate,
+			DeathDate:     authorRequest.DeathDate,
+			Bio:           authorRequest.Bio,
+			Website:       authorRequest.Website,
+			OpenLibraryId: authorRequest.OpenLibraryId,
+			WikidataId:    authorRequest.WikidataId,
+			PhotoKey:      authorRequest.PhotoKey,
+		}
+
+		mockService := &MockAuthorService{
+			MockCreateResponse: expectedServiceResponse,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors", ToJSON(authorRequest))
+		res := httptest.NewRecorder()
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if !ok {
+			t.Fatal("val should be true but got false")
+		}
+
+		for field, expected := range map[string]string{
+			"birth_date":      expectedServiceResponse.BirthDate,
+			"death_date":      expectedServiceResponse.DeathDate,
+			"bio":             expectedServiceResponse.Bio,
+			"open_library_id": expectedServiceResponse.OpenLibraryId,
+			"wikidata_id":     expectedServiceResponse.WikidataId,
+			"photo_key":       expectedServiceResponse.PhotoKey,
+		} {
+			if val[field] != expected {
+				t.Errorf("expected %s as %s but got %v", expected, field, val[field])
+			}
+		}
+
+		// Check actual request body that has been parsed in service
+		if !reflect.DeepEqual(mockService.CreateCalledWithRequest, authorRequest) {
+			t.Errorf("expected %+v as request body but got %+v", authorRequest, mockService.CreateCalledWithRequest)
+		}
+	})
+
+	t.Run("create author with invalid profile", func(t *testing.T) {
+		profile := web.CreateAuthorRequest{
+			FullName:    "Gabriel García Márquez",
+			Nationality: "Colombia",
+		}
+
+		cases := []struct {
+			Name       string
+			Update     func(request *web.CreateAuthorRequest)
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "birth_date format",
+				Update:     func(request *web.CreateAuthorRequest) { request.BirthDate = "03-06-1927" },
+				ErrField:   "birth_date",
+				ErrMessage: "use YYYY-MM-DD for valid datetime",
+			},
+			{
+				Name:       "website scheme",
+				Update:     func(request *web.CreateAuthorRequest) { request.Website = "ftp://example.com" },
+				ErrField:   "website",
+				ErrMessage: "website must be an http or https URL",
+			},
+			{
+				Name:       "open_library_id of a work",
+				Update:     func(request *web.CreateAuthorRequest) { request.OpenLibraryId = "OL274505W" },
+				ErrField:   "open_library_id",
+				ErrMessage: "'OL274505W' is not a valid Open Library author ID such as 'OL23919A'",
+			},
+			{
+				Name:       "wikidata_id without Q",
+				Update:     func(request *web.CreateAuthorRequest) { request.WikidataId = "5878" },
+				ErrField:   "wikidata_id",
+				ErrMessage: "'5878' is not a valid Wikidata ID such as 'Q5879'",
+			},
+			{
+				Name:       "photo_key not a JPEG",
+				Update:     func(request *web.CreateAuthorRequest) { request.PhotoKey = "portrait.png" },
+				ErrField:   "photo_key",
+				ErrMessage: "'portrait.png' is not a valid photo key",
+			},
+			{
+				Name:       "photo_key of a book cover",
+				Update:     func(request *web.CreateAuthorRequest) { request.PhotoKey = "7d1e2f3a-4b5c-4d6e-8f7a-9b0c1d2e3f4a.jpg" },
+				ErrField:   "photo_key",
+				ErrMessage: "'7d1e2f3a-4b5c-4d6e-8f7a-9b0c1d2e3f4a.jpg' is not a portrait key, upload it through the author presigned URL",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				authorRequest := profile
+				c.Update(&authorRequest)
+
+				mockService := &MockAuthorService{
+					MockError: validate.Struct(authorRequest),
+				}
+
+				handler := NewAuthorHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/authors", ToJSON(authorRequest))
+				res := httptest.NewRecorder()
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if !ok || len(errorList) == 0 {
+					t.Fatal("errorList should be true but got false")
+				}
+
+				val, ok := errorList[0].(map[string]interface{})
+				if !ok {
+					t.Fatal("val should be true but got false")
+				}
+
+				if val["field"] != c.ErrField {
+					t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+				}
+
+				if val["message"] != c.ErrMessage {
+					t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+				}
+			})
+		}
+	})
+
+	t.Run("create author who died before they were born", func(t *testing.T) {
+		authorRequest := web.CreateAuthorRequest{
+			FullName:    "Gabriel García Márquez",
+			Nationality: "Colombia",
+			BirthDate:   "2014-04-17",
+			DeathDate:   "1927-03-06",
+		}
+
+		mockService := &MockAuthorService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "death_date",
+						Message: "death_date must not be before birth_date",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors", ToJSON(authorRequest))
+		res := httptest.NewRecorder()
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebFailedResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		errorList, ok := actualResponseBody.Errors.([]interface{})
+		if !ok || len(errorList) == 0 {
+			t.Fatal("errorList should be true but got false")
+		}
+
+		val, ok := errorList[0].(map[string]interface{})
+		if !ok {
+			t.Fatal("val should be true but got false")
+		}
+
+		if val["field"] != "death_date" || val["message"] != "death_date must not be before birth_date" {
+			t.Errorf("expected death_date must not be before birth_date but got %v", val)
+		}
+	})
+
 	t.Run("create author with invalid JSON payload", func(t *testing.T) {
 		invalidJSONPayload := `{"full_name":}`
 		mockService := &MockAuthorService{}
@@ -859,7 +1136,7 @@ func TestAuthorGetAllHandler(t *testing.T) {
 				ErrMessage: "nationality must be at most 255 characters",
 			},
 			{
-				Name: "alpha only",
+				Name: "valid nationality",
 				Query: web.QueryParamsGetAuthors{
 					Nationality: "Invalid FullName #123",
 				},
@@ -924,33 +1201,38 @@ func TestAuthorGetAllHandler(t *testing.T) {
 			})
 		}
 	})
-}
-
-func TestAuthorGetByIdHandler(t *testing.T) {
-	t.Run("get author by id", func(t *testing.T) {
-		pathValue := web.PathParamsGetAuthor{
-			Id: "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+	t.Run("get authors with pagination query parameters", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetAuthors{
+			Page:     2,
+			PageSize: 1,
+			Sort:     "-created_at",
 		}
-		expectedServiceResponse := web.GetAuthorResponse{
-			Id:          "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
-			FullName:    "Leila S. Chudori",
-			Nationality: "Indonesia",
+		nextPage := 3
+		expectedServiceMeta := web.PaginationMeta{
+			Page:       2,
+			PageSize:   1,
+			Total:      3,
+			TotalPages: 3,
+			NextPage:   &nextPage,
 		}
 
 		mockService := &MockAuthorService{
-			GetByIdCalledWithPathValue: pathValue,
-			MockGetByIdResponse:        expectedServiceResponse,
+			MockGetAllResponse: []web.GetAuthorResponse{
+				{
+					Id:          "84a069f3-2620-4da4-8bb5-5c39bbe7cda7",
+					FullName:    "Henry Manampiring",
+					Nationality: "Indonesia",
+				},
+			},
+			MockGetAllMeta: expectedServiceMeta,
 		}
 
 		handler := NewAuthorHandler(mockService)
 
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors/c512ae16-5f33-4a3c-a1e1-977bd5a20af3", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors?page=2&page_size=1&sort=-created_at", nil)
 		res := httptest.NewRecorder()
 
-		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
-		req.SetPathValue("id", "c512ae16-5f33-4a3c-a1e1-977bd5a20af3")
-
-		handler.GetById(res, req)
+		handler.GetAll(res, req)
 
 		// Check status code
 		if res.Code != http.StatusOK {
@@ -964,60 +1246,259 @@ func TestAuthorGetByIdHandler(t *testing.T) {
 			t.Fatalf("error when parsing res body: %v", err)
 		}
 
-		// Check response body message
-		if actualResponseBody.Message != "Success get author" {
-			t.Errorf("expected %s as response message but got %s", "Success get author", actualResponseBody.Message)
-		}
-
-		// Check response body data
-		val, ok := actualResponseBody.Data.(map[string]interface{})
+		// Check response body meta
+		val, ok := actualResponseBody.Meta.(map[string]interface{})
 		if ok {
-			if val["id"] != expectedServiceResponse.Id {
-				t.Errorf("expected %s as id but got %s", expectedServiceResponse.Id, val["id"])
+			if int(val["page"].(float64)) != expectedServiceMeta.Page {
+				t.Errorf("expected %d as page but got %v", expectedServiceMeta.Page, val["page"])
 			}
 
-			if val["full_name"] != expectedServiceResponse.FullName {
-				t.Errorf("expected %s as full_name but got %s", expectedServiceResponse.FullName, val["full_name"])
+			if int(val["total"].(float64)) != expectedServiceMeta.Total {
+				t.Errorf("expected %d as total but got %v", expectedServiceMeta.Total, val["total"])
 			}
 
-			if val["nationality"] != expectedServiceResponse.Nationality {
-				t.Errorf("expected %s as nationality but got %s", expectedServiceResponse.Nationality, val["nationality"])
+			if int(val["next_page"].(float64)) != nextPage {
+				t.Errorf("expected %d as next_page but got %v", nextPage, val["next_page"])
 			}
 		} else {
 			t.Error("val should be true but got false")
 		}
 
-		// Check actual path values that has been parsed in service
-		if !reflect.DeepEqual(mockService.GetByIdCalledWithPathValue, pathValue) {
-			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.GetByIdCalledWithPathValue)
+		// Check actual queries params that has been parsed in service
+		if !reflect.DeepEqual(mockService.GetAllCalledWithQuery, expectedQueries) {
+			t.Errorf("expected %+v as query params but got %+v", expectedQueries, mockService.GetAllCalledWithQuery)
 		}
 	})
 
-	t.Run("get author by id with invalid UUID", func(t *testing.T) {
-		invalidUUID := "InvalidUUID"
-
-		pathValue := web.PathParamsGetAuthor{
-			Id: invalidUUID,
+	t.Run("get authors with invalid pagination query parameters", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			RawQuery   string
+			Query      web.QueryParamsGetAuthors
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "page is not a number",
+				RawQuery:   "page=two",
+				ErrField:   "page",
+				ErrMessage: "page must be a number",
+			},
+			{
+				Name:     "page too large for an offset",
+				RawQuery: "page=9223372036854775807",
+				Query: web.QueryParamsGetAuthors{
+					Page: math.MaxInt64,
+				},
+				ErrField:   "page",
+				ErrMessage: "page must be at most 10000 characters",
+			},
+			{
+				Name:     "page_size above maximum",
+				RawQuery: "page_size=101",
+				Query: web.QueryParamsGetAuthors{
+					PageSize: 101,
+				},
+				ErrField:   "page_size",
+				ErrMessage: "page_size must be at most 100 characters",
+			},
+			{
+				Name:     "unknown sort",
+				RawQuery: "sort=total_page",
+				Query: web.QueryParamsGetAuthors{
+					Sort: "total_page",
+				},
+				ErrField:   "sort",
+				ErrMessage: "sort must be one of 'name', '-name', 'created_at', '-created_at'",
+			},
 		}
+
 		validate := config.ValidatorInit()
-		expectedServiceError := validate.Struct(pathValue)
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockAuthorService{
+					MockError: validate.Struct(c.Query),
+				}
 
-		mockService := &MockAuthorService{
-			MockError: expectedServiceError,
-		}
+				handler := NewAuthorHandler(mockService)
 
-		handler := NewAuthorHandler(mockService)
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/authors?"+c.RawQuery, nil)
+				res := httptest.NewRecorder()
 
-		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/authors/%s", invalidUUID), nil)
-		res := httptest.NewRecorder()
+				handler.GetAll(res, req)
 
-		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
-		req.SetPathValue("id", invalidUUID)
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
 
-		handler.GetById(res, req)
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
 
-		// Check status code
-		if res.Code != http.StatusBadRequest {
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestAuthorGetByIdHandler(t *testing.T) {
+	t.Run("get author by id", func(t *testing.T) {
+		pathValue := web.PathParamsGetAuthor{
+			Id: "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+		}
+		expectedServiceResponse := web.GetAuthorResponse{
+			Id:          "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+			FullName:    "Leila S. Chudori",
+			Nationality: "Indonesia",
+		}
+
+		mockService := &MockAuthorService{
+			GetByIdCalledWithPathValue: pathValue,
+			MockGetByIdResponse:        expectedServiceResponse,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors/c512ae16-5f33-4a3c-a1e1-977bd5a20af3", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "c512ae16-5f33-4a3c-a1e1-977bd5a20af3")
+
+		handler.GetById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body message
+		if actualResponseBody.Message != "Success get author" {
+			t.Errorf("expected %s as response message but got %s", "Success get author", actualResponseBody.Message)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["id"] != expectedServiceResponse.Id {
+				t.Errorf("expected %s as id but got %s", expectedServiceResponse.Id, val["id"])
+			}
+
+			if val["full_name"] != expectedServiceResponse.FullName {
+				t.Errorf("expected %s as full_name but got %s", expectedServiceResponse.FullName, val["full_name"])
+			}
+
+			if val["nationality"] != expectedServiceResponse.Nationality {
+				t.Errorf("expected %s as nationality but got %s", expectedServiceResponse.Nationality, val["nationality"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path values that has been parsed in service
+		if !reflect.DeepEqual(mockService.GetByIdCalledWithPathValue, pathValue) {
+			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.GetByIdCalledWithPathValue)
+		}
+	})
+
+	t.Run("get author by id with a portrait", func(t *testing.T) {
+		expectedServiceResponse := web.GetAuthorResponse{
+			Id:          "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+			FullName:    "Leila S. Chudori",
+			Nationality: "Indonesia",
+			PhotoKey:    "authors/9b2c7e41-5d0a-4f3e-8c61-2a7d4e9f0b13.jpg",
+			PhotoURL:    "http://127.0.0.1:9000/author-images/authors/9b2c7e41-5d0a-4f3e-8c61-2a7d4e9f0b13.jpg?X-Amz-Expires=86400",
+		}
+
+		mockService := &MockAuthorService{
+			MockGetByIdResponse: expectedServiceResponse,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors/c512ae16-5f33-4a3c-a1e1-977bd5a20af3", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "c512ae16-5f33-4a3c-a1e1-977bd5a20af3")
+
+		handler.GetById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if !ok {
+			t.Fatal("val should be true but got false")
+		}
+
+		if val["photo_url"] != expectedServiceResponse.PhotoURL {
+			t.Errorf("expected %s as photo_url but got %v", expectedServiceResponse.PhotoURL, val["photo_url"])
+		}
+	})
+
+	t.Run("get author by id with invalid UUID", func(t *testing.T) {
+		invalidUUID := "InvalidUUID"
+
+		pathValue := web.PathParamsGetAuthor{
+			Id: invalidUUID,
+		}
+		validate := config.ValidatorInit()
+		expectedServiceError := validate.Struct(pathValue)
+
+		mockService := &MockAuthorService{
+			MockError: expectedServiceError,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/authors/%s", invalidUUID), nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", invalidUUID)
+
+		handler.GetById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
 			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
 		}
 
@@ -1654,7 +2135,7 @@ func TestAuthorUpdateHandler(t *testing.T) {
 				ErrMessage: "nationality is required",
 			},
 			{
-				Name: "alpha only",
+				Name: "valid nationality",
 				AuthorRequest: web.UpdateAuthorRequest{
 					FullName:    "Leila S. Chudori",
 					Nationality: "Invalid Nationality Name #123",
@@ -1872,6 +2353,353 @@ func TestAuthorDeleteHandler(t *testing.T) {
 			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.DeleteByIdCalledWithPathValue)
 		}
 	})
+
+	t.Run("delete author who still has books", func(t *testing.T) {
+		expectedServiceError := appError.NewAppError(
+			http.StatusConflict,
+			[]appError.ErrAggregate{
+				{
+					Field:   "book_id",
+					Message: "author is a contributor of book with id '43723811-c8e3-4cba-85cc-142954064ae4', use mode=cascade or reassign_to",
+				},
+				{
+					Field:   "book_id",
+					Message: "author is a contributor of book with id '9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d', use mode=cascade or reassign_to",
+				},
+			},
+			fmt.Errorf("author with id '%s' has %d books", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a", 2),
+		)
+
+		mockService := &MockAuthorService{
+			MockError: expectedServiceError,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusConflict {
+			t.Errorf("expected status code of %d but got %d", http.StatusConflict, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebFailedResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check every blocking book is listed
+		errorList, ok := actualResponseBody.Errors.([]interface{})
+		if !ok {
+			t.Fatal("errorList should be true but got false")
+		}
+
+		if len(errorList) != 2 {
+			t.Errorf("expected 2 blocking books but got %d", len(errorList))
+		}
+
+		// Without query params the service restricts the deletion
+		if !reflect.DeepEqual(mockService.DeleteByIdCalledWithQuery, web.QueryParamsDeleteAuthor{}) {
+			t.Errorf("expected no query params but got %+v", mockService.DeleteByIdCalledWithQuery)
+		}
+	})
+
+	t.Run("delete author with books reassigned", func(t *testing.T) {
+		expectedQuery := web.QueryParamsDeleteAuthor{
+			ReassignTo: "8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f",
+		}
+
+		mockService := &MockAuthorService{}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a?reassign_to=8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNoContent {
+			t.Errorf("expected status code of %d but got %d", http.StatusNoContent, res.Code)
+		}
+
+		// Check actual query params that has been parsed in service
+		if !reflect.DeepEqual(mockService.DeleteByIdCalledWithQuery, expectedQuery) {
+			t.Errorf("expected %+v as query params but got %+v", expectedQuery, mockService.DeleteByIdCalledWithQuery)
+		}
+	})
+
+	t.Run("delete author with invalid query params", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			Query      web.QueryParamsDeleteAuthor
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "unknown mode",
+				Query:      web.QueryParamsDeleteAuthor{Mode: "orphan"},
+				ErrField:   "mode",
+				ErrMessage: "mode must be one of 'restrict', 'cascade'",
+			},
+			{
+				Name:       "mode with reassign_to",
+				Query:      web.QueryParamsDeleteAuthor{Mode: "cascade", ReassignTo: "8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+				ErrField:   "reassign_to",
+				ErrMessage: "reassign_to must not be given with mode",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockAuthorService{
+					MockError: validate.Struct(c.Query),
+				}
+
+				handler := NewAuthorHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodDelete, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a", nil)
+				res := httptest.NewRecorder()
+
+				handler.DeleteById(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestAuthorMergeHandler(t *testing.T) {
+	t.Run("merge duplicate authors", func(t *testing.T) {
+		pathValue := web.PathParamsMergeAuthors{
+			Id: "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+		}
+		mergeRequest := web.MergeAuthorsRequest{
+			DuplicateIds: []string{"8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+		}
+		expectedServiceResponse := web.MergeAuthorsResponse{
+			Id:          "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+			FullName:    "J.R.R. Tolkien",
+			Nationality: "British",
+			Aliases:     []string{"J. R. R. Tolkien"},
+			MergedIds:   []string{"8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+		}
+
+		mockService := &MockAuthorService{
+			MockMergeResponse: expectedServiceResponse,
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/merge", ToJSON(mergeRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.Merge(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			aliases, ok := val["aliases"].([]interface{})
+			if !ok || len(aliases) != 1 || aliases[0] != "J. R. R. Tolkien" {
+				t.Errorf("expected aliases [J. R. R. Tolkien] but got %v", val["aliases"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path value and request body that has been parsed in service
+		if !reflect.DeepEqual(mockService.MergeCalledWithPathValue, pathValue) {
+			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.MergeCalledWithPathValue)
+		}
+
+		if !reflect.DeepEqual(mockService.MergeCalledWithRequest, mergeRequest) {
+			t.Errorf("expected %+v as request body but got %+v", mergeRequest, mockService.MergeCalledWithRequest)
+		}
+	})
+
+	t.Run("merge authors with colliding books", func(t *testing.T) {
+		mockService := &MockAuthorService{
+			MockMergeResponse: web.MergeAuthorsResponse{
+				Id:        "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a",
+				FullName:  "J.R.R. Tolkien",
+				MergedIds: []string{"8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+				Collisions: []web.BookCollisionResponse{
+					{
+						Name:          "The Hobbit",
+						BookId:        "43723811-c8e3-4cba-85cc-142954064ae4",
+						MergedBookIds: []string{"9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d"},
+					},
+				},
+			},
+		}
+
+		handler := NewAuthorHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/merge", ToJSON(web.MergeAuthorsRequest{
+			DuplicateIds: []string{"8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+		}))
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.Merge(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if !ok {
+			t.Fatal("val should be true but got false")
+		}
+
+		collisions, ok := val["collisions"].([]interface{})
+		if !ok || len(collisions) != 1 {
+			t.Errorf("expected 1 collision but got %v", val["collisions"])
+		}
+	})
+
+	t.Run("merge authors with invalid request", func(t *testing.T) {
+		cases := []struct {
+			Name         string
+			MergeRequest web.MergeAuthorsRequest
+			ErrField     string
+			ErrMessage   string
+		}{
+			{
+				Name:         "no duplicate_ids",
+				MergeRequest: web.MergeAuthorsRequest{},
+				ErrField:     "duplicate_ids",
+				ErrMessage:   "duplicate_ids is required",
+			},
+			{
+				Name: "same duplicate twice",
+				MergeRequest: web.MergeAuthorsRequest{
+					DuplicateIds: []string{"8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f", "8c1f2e3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f"},
+				},
+				ErrField:   "duplicate_ids",
+				ErrMessage: "duplicate_ids must not contain the same value twice",
+			},
+			{
+				Name: "invalid UUID",
+				MergeRequest: web.MergeAuthorsRequest{
+					DuplicateIds: []string{"InvalidUUID"},
+				},
+				ErrField:   "duplicate_ids[0]",
+				ErrMessage: "'InvalidUUID' is not a valid UUID",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockAuthorService{
+					MockError: validate.Struct(c.MergeRequest),
+				}
+
+				handler := NewAuthorHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/authors/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/merge", ToJSON(c.MergeRequest))
+				res := httptest.NewRecorder()
+
+				handler.Merge(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
 }
 
 // Helper functions
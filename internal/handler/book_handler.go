@@ -8,4 +8,5 @@ type BookHandler interface {
 	GetById(w http.ResponseWriter, r *http.Request)
 	UpdateById(w http.ResponseWriter, r *http.Request)
 	DeleteById(w http.ResponseWriter, r *http.Request)
+	Lookup(w http.ResponseWriter, r *http.Request)
 }
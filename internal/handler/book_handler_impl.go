@@ -11,9 +11,14 @@ import (
 )
 
 const (
-	queryName       = "name"
-	queryStatus     = "status"
-	queryAuthorName = "author_name"
+	queryName          = "name"
+	queryStatus        = "status"
+	queryAuthorName    = "author_name"
+	queryRatingMin     = "rating_min"
+	queryTags          = "tags"
+	queryTagsMatch     = "tags_match"
+	queryCompletedFrom = "completed_from"
+	queryCompletedTo   = "completed_to"
 )
 
 func NewBookHandler(bookService service.BookService) BookHandler {
@@ -57,15 +62,42 @@ func (handler *BookHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (handler *BookHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get books")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get books")
+		return
+	}
+
+	ratingMin, err := readFloatQuery(r, queryRatingMin)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get books")
+		return
+	}
+
 	// Get query params if any
 	queries := web.QueryParamsGetBooks{
-		Name:       r.URL.Query().Get(queryName),
-		Status:     r.URL.Query().Get(queryStatus),
-		AuthorName: r.URL.Query().Get(queryAuthorName),
+		Name:          r.URL.Query().Get(queryName),
+		Status:        r.URL.Query().Get(queryStatus),
+		AuthorName:    r.URL.Query().Get(queryAuthorName),
+		CompletedFrom: r.URL.Query().Get(queryCompletedFrom),
+		CompletedTo:   r.URL.Query().Get(queryCompletedTo),
+		RatingMin:     ratingMin,
+		Tags:          readListQuery(r, queryTags),
+		TagsMatch:     r.URL.Query().Get(queryTagsMatch),
+		Page:          page,
+		PageSize:      pageSize,
+		Sort:          r.URL.Query().Get(querySort),
 	}
 
 	// Call the service
-	authorsResponse, err := handler.BookService.GetAllBooks(r.Context(), queries)
+	authorsResponse, meta, err := handler.BookService.GetAllBooks(r.Context(), queries)
 	if err != nil {
 		appError.ResponseServiceErrorHandler(w, err, "failed to get books")
 		return
@@ -82,6 +114,7 @@ func (handler *BookHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
 	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
 		Message: "Success get all books",
 		Data:    authorsResponse,
+		Meta:    meta,
 	})
 }
 
@@ -170,3 +203,33 @@ func (handler *BookHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Reques
 	// Set to 204 No Content
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (handler *BookHandlerImpl) Lookup(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to lookupRequest
+	lookupRequest := web.LookupBookRequest{}
+	err := helper.ReadFromRequestBody(r, &lookupRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	lookupResponse, err := handler.BookService.LookupBookByIsbn(r.Context(), lookupRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to lookup book by isbn")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success lookup book",
+		Data:    lookupResponse,
+	})
+}
@@ -0,0 +1,7 @@
+package handler
+
+import "net/http"
+
+type ExportHandler interface {
+	ExportBooks(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const queryFormat = "format"
+
+func NewExportHandler(exportService service.ExportService) ExportHandler {
+	return &ExportHandlerImpl{
+		ExportService: exportService,
+	}
+}
+
+type ExportHandlerImpl struct {
+	ExportService service.ExportService
+}
+
+func (handler *ExportHandlerImpl) ExportBooks(w http.ResponseWriter, r *http.Request) {
+	// Get query params if any
+	queries := web.QueryParamsExportBooks{
+		Format: r.URL.Query().Get(queryFormat),
+	}
+
+	contentType, extension := helper.ExportContentType(queries.Format)
+	exportWriter := &streamResponseWriter{
+		ResponseWriter: w,
+		contentType:    contentType,
+		fileName:       fmt.Sprintf("bookshelf-export.%s", extension),
+	}
+
+	// Call the service
+	err := handler.ExportService.ExportBooks(r.Context(), queries, exportWriter)
+	if err != nil {
+		// Once the first row is sent the status code can not be changed anymore
+		if exportWriter.started {
+			slog.Error("export aborted while streaming", "err", err)
+			return
+		}
+
+		appError.ResponseServiceErrorHandler(w, err, "failed to export books")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+}
+
+// streamResponseWriter sends the download headers right before the first byte,
+// so an error returned before anything is written can still be sent as a WebFailedResponse
+type streamResponseWriter struct {
+	http.ResponseWriter
+	contentType string
+	fileName    string
+	started     bool
+}
+
+func (w *streamResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.Header().Set("Content-Type", w.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.fileName))
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(p)
+}
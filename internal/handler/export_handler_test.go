@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockExportService struct {
+	// ExportBooks
+	ExportCalledWithQueries web.QueryParamsExportBooks
+	MockExportBody          string
+
+	MockError error
+}
+
+func (m *MockExportService) ExportBooks(ctx context.Context, queries web.QueryParamsExportBooks, w io.Writer) error {
+	m.ExportCalledWithQueries = queries
+
+	if m.MockExportBody != "" {
+		if _, err := io.WriteString(w, m.MockExportBody); err != nil {
+			return err
+		}
+	}
+
+	return m.MockError
+}
+
+func TestExportBooksHandler(t *testing.T) {
+	t.Run("export books as csv", func(t *testing.T) {
+		expectedBody := "id,name\n43723811-c8e3-4cba-85cc-142954064ae4,Dune\n"
+
+		mockService := &MockExportService{
+			MockExportBody: expectedBody,
+		}
+
+		handler := NewExportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export?format=csv", nil)
+		res := httptest.NewRecorder()
+
+		handler.ExportBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Check download headers
+		if res.Header().Get("Content-Type") != "text/csv" {
+			t.Errorf("expected Content-Type text/csv but got %s", res.Header().Get("Content-Type"))
+		}
+
+		if res.Header().Get("Content-Disposition") != `attachment; filename="bookshelf-export.csv"` {
+			t.Errorf("expected csv attachment but got %s", res.Header().Get("Content-Disposition"))
+		}
+
+		if res.Body.String() != expectedBody {
+			t.Errorf("expected %q as body but got %q", expectedBody, res.Body.String())
+		}
+
+		// Check actual queries that has been passed to service
+		if mockService.ExportCalledWithQueries.Format != "csv" {
+			t.Errorf("expected csv as format but got %s", mockService.ExportCalledWithQueries.Format)
+		}
+	})
+
+	t.Run("export books with invalid format", func(t *testing.T) {
+		queries := web.QueryParamsExportBooks{
+			Format: "xml",
+		}
+
+		mockService := &MockExportService{
+			MockError: config.ValidatorInit().Struct(queries),
+		}
+
+		handler := NewExportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export?format=xml", nil)
+		res := httptest.NewRecorder()
+
+		handler.ExportBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+
+		// A failed export is not a download
+		if res.Header().Get("Content-Disposition") != "" {
+			t.Errorf("expected no Content-Disposition but got %s", res.Header().Get("Content-Disposition"))
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebFailedResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		errorList, ok := actualResponseBody.Errors.([]interface{})
+		if ok {
+			val, ok := errorList[0].(map[string]interface{})
+			if ok {
+				if val["field"] != "format" {
+					t.Errorf("expected error field is %s but got %s", "format", val["field"])
+				}
+
+				if val["message"] != "format must be one of 'json', 'csv', 'goodreads'" {
+					t.Errorf("expected error message is %s but got %s", "format must be one of 'json', 'csv', 'goodreads'", val["message"])
+				}
+			} else {
+				t.Error("val should be true but got false")
+			}
+		} else {
+			t.Error("errorList should be true but got false")
+		}
+	})
+
+	t.Run("export books fails after streaming started", func(t *testing.T) {
+		mockService := &MockExportService{
+			MockExportBody: `{"message":"Success export books","data":[`,
+			MockError:      errors.New("connection reset"),
+		}
+
+		handler := NewExportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export", nil)
+		res := httptest.NewRecorder()
+
+		handler.ExportBooks(res, req)
+
+		// The status code has already been sent
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		if res.Body.String() != mockService.MockExportBody {
+			t.Errorf("expected the body to stop at %q but got %q", mockService.MockExportBody, res.Body.String())
+		}
+	})
+}
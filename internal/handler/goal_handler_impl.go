@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const (
+	queryMetric = "metric"
+	queryYear   = "year"
+)
+
+func NewGoalHandler(goalService service.GoalService) GoalHandler {
+	return &GoalHandlerImpl{
+		GoalService: goalService,
+	}
+}
+
+type GoalHandlerImpl struct {
+	GoalService service.GoalService
+}
+
+func (handler *GoalHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to goalRequest
+	goalRequest := web.CreateGoalRequest{}
+	err := helper.ReadFromRequestBody(r, &goalRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	goalResponse, err := handler.GoalService.CreateNewGoal(r.Context(), goalRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new goal")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Goal created successfully",
+		Data:    goalResponse,
+	})
+}
+
+func (handler *GoalHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get query params if any
+	year, err := readIntQuery(r, queryYear)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goals")
+		return
+	}
+
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goals")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goals")
+		return
+	}
+
+	queries := web.QueryParamsGetGoals{
+		Metric:   r.URL.Query().Get(queryMetric),
+		Year:     year,
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	goalsResponse, meta, err := handler.GoalService.GetAllGoals(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goals")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all goals",
+		Data:    goalsResponse,
+		Meta:    meta,
+	})
+}
+
+func (handler *GoalHandlerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGoal{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	goalResponse, err := handler.GoalService.GetGoalById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goal by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get goal",
+		Data:    goalResponse,
+	})
+}
+
+func (handler *GoalHandlerImpl) UpdateById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGoal{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to goalRequest
+	goalRequest := web.UpdateGoalRequest{}
+	err := helper.ReadFromRequestBody(r, &goalRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	goalResponse, err := handler.GoalService.UpdateGoalById(r.Context(), pathValue, goalRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to update goal by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Goal updated successfully",
+		Data:    goalResponse,
+	})
+}
+
+func (handler *GoalHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGoal{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	err := handler.GoalService.DeleteGoalById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete goal by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *GoalHandlerImpl) GetProgress(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGoal{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	progressResponse, err := handler.GoalService.GetGoalProgress(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get goal progress")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get goal progress",
+		Data:    progressResponse,
+	})
+}
@@ -0,0 +1,369 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockGoalService struct {
+	// CreateNewGoal, GetGoalById, UpdateGoalById and DeleteGoalById
+	MockPathValue     web.PathParamsGoal
+	CreateMockRequest web.CreateGoalRequest
+	UpdateMockRequest web.UpdateGoalRequest
+	GoalMockResponse  web.GoalResponse
+
+	// GetAllGoals
+	GetAllMockQuery   web.QueryParamsGetGoals
+	GoalsMockResponse []web.GoalResponse
+	GoalsMockMeta     web.PaginationMeta
+
+	// GetGoalProgress
+	ProgressMockResponse web.GoalProgressResponse
+
+	MockError error
+}
+
+func (m *MockGoalService) CreateNewGoal(ctx context.Context, request web.CreateGoalRequest) (web.GoalResponse, error) {
+	m.CreateMockRequest = request
+
+	if m.MockError != nil {
+		return web.GoalResponse{}, m.MockError
+	}
+
+	return m.GoalMockResponse, nil
+}
+
+func (m *MockGoalService) GetAllGoals(ctx context.Context, queries web.QueryParamsGetGoals) ([]web.GoalResponse, web.PaginationMeta, error) {
+	m.GetAllMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.GoalsMockResponse, m.GoalsMockMeta, nil
+}
+
+func (m *MockGoalService) GetGoalById(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalResponse, error) {
+	m.MockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.GoalResponse{}, m.MockError
+	}
+
+	return m.GoalMockResponse, nil
+}
+
+func (m *MockGoalService) UpdateGoalById(ctx context.Context, pathValues web.PathParamsGoal, request web.UpdateGoalRequest) (web.GoalResponse, error) {
+	m.MockPathValue = pathValues
+	m.UpdateMockRequest = request
+
+	if m.MockError != nil {
+		return web.GoalResponse{}, m.MockError
+	}
+
+	return m.GoalMockResponse, nil
+}
+
+func (m *MockGoalService) DeleteGoalById(ctx context.Context, pathValues web.PathParamsGoal) error {
+	m.MockPathValue = pathValues
+
+	return m.MockError
+}
+
+func (m *MockGoalService) GetGoalProgress(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalProgressResponse, error) {
+	m.MockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.GoalProgressResponse{}, m.MockError
+	}
+
+	return m.ProgressMockResponse, nil
+}
+
+func TestGoalCreateHandler(t *testing.T) {
+	t.Run("create yearly goal", func(t *testing.T) {
+		goalRequest := web.CreateGoalRequest{
+			Metric: "books",
+			Target: 24,
+			Period: "year",
+			Year:   2026,
+		}
+		expectedServiceResponse := web.GoalResponse{
+			Id:        "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a",
+			Metric:    "books",
+			Target:    24,
+			Period:    "year",
+			Year:      2026,
+			StartDate: "2026-01-01",
+			EndDate:   "2026-12-31",
+		}
+
+		mockService := &MockGoalService{
+			GoalMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewGoalHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/goals", ToJSON(goalRequest))
+		res := httptest.NewRecorder()
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["end_date"] != expectedServiceResponse.EndDate {
+				t.Errorf("expected end_date '%s' but got '%s'", expectedServiceResponse.EndDate, val["end_date"])
+			}
+
+			if _, ok := val["month"]; ok {
+				t.Errorf("expected no month for a yearly goal but got %v", val["month"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual request body that has been passed to service
+		if !reflect.DeepEqual(mockService.CreateMockRequest, goalRequest) {
+			t.Errorf("expected %+v as request body but got %+v", goalRequest, mockService.CreateMockRequest)
+		}
+	})
+
+	t.Run("create goal with invalid period", func(t *testing.T) {
+		cases := []struct {
+			Name        string
+			GoalRequest web.CreateGoalRequest
+			ErrField    string
+			ErrMessage  string
+		}{
+			{
+				Name: "monthly goal without month",
+				GoalRequest: web.CreateGoalRequest{
+					Metric: "pages",
+					Target: 1500,
+					Period: "month",
+					Year:   2026,
+				},
+				ErrField:   "month",
+				ErrMessage: "month is required",
+			},
+			{
+				Name: "yearly goal with quarter",
+				GoalRequest: web.CreateGoalRequest{
+					Metric:  "books",
+					Target:  24,
+					Period:  "year",
+					Year:    2026,
+					Quarter: 2,
+				},
+				ErrField:   "quarter",
+				ErrMessage: "quarter must not be given for this period",
+			},
+			{
+				Name: "unknown period",
+				GoalRequest: web.CreateGoalRequest{
+					Metric: "books",
+					Target: 2,
+					Period: "week",
+					Year:   2026,
+				},
+				ErrField:   "period",
+				ErrMessage: "period must be one of 'year', 'quarter', 'month'",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockGoalService{
+					MockError: validate.Struct(c.GoalRequest),
+				}
+
+				handler := NewGoalHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/goals", ToJSON(c.GoalRequest))
+				res := httptest.NewRecorder()
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestGoalGetAllHandler(t *testing.T) {
+	t.Run("get goals of a year", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetGoals{
+			Metric: "pages",
+			Year:   2026,
+			Sort:   "start_date",
+		}
+
+		mockService := &MockGoalService{
+			GoalsMockResponse: []web.GoalResponse{},
+		}
+
+		handler := NewGoalHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/goals?metric=pages&year=2026&sort=start_date", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.GetAllMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.GetAllMockQuery)
+		}
+	})
+}
+
+func TestGoalGetProgressHandler(t *testing.T) {
+	t.Run("get goal progress", func(t *testing.T) {
+		expectedServiceResponse := web.GoalProgressResponse{
+			GoalId:              "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a",
+			Metric:              "books",
+			Target:              24,
+			StartDate:           "2026-01-01",
+			EndDate:             "2026-12-31",
+			Current:             9,
+			Remaining:           15,
+			Percent:             37.5,
+			Expected:            12.03,
+			DaysTotal:           365,
+			DaysElapsed:         183,
+			DaysLeft:            182,
+			ExpectedPacePerWeek: 0.46,
+			ActualPacePerWeek:   0.34,
+			RequiredPacePerWeek: 0.58,
+			Status:              web.GoalStatusBehind,
+		}
+
+		mockService := &MockGoalService{
+			ProgressMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewGoalHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/goals/0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a/progress", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a")
+
+		handler.GetProgress(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["status"] != expectedServiceResponse.Status {
+				t.Errorf("expected status '%s' but got '%s'", expectedServiceResponse.Status, val["status"])
+			}
+
+			if val["expected_pace_per_week"] != expectedServiceResponse.ExpectedPacePerWeek || val["actual_pace_per_week"] != expectedServiceResponse.ActualPacePerWeek {
+				t.Errorf("expected paces %v and %v but got %v and %v", expectedServiceResponse.ExpectedPacePerWeek, expectedServiceResponse.ActualPacePerWeek, val["expected_pace_per_week"], val["actual_pace_per_week"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		if mockService.MockPathValue.Id != "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a" {
+			t.Errorf("expected id %s but got %s", "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a", mockService.MockPathValue.Id)
+		}
+	})
+
+	t.Run("get progress of unknown goal", func(t *testing.T) {
+		mockService := &MockGoalService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: "goal with id '0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a' is not found",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewGoalHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/goals/0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a/progress", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a")
+
+		handler.GetProgress(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package handler
+
+import "net/http"
+
+type HighlightHandler interface {
+	GetAll(w http.ResponseWriter, r *http.Request)
+}
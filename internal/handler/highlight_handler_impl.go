@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+func NewHighlightHandler(highlightService service.HighlightService) HighlightHandler {
+	return &HighlightHandlerImpl{
+		HighlightService: highlightService,
+	}
+}
+
+type HighlightHandlerImpl struct {
+	HighlightService service.HighlightService
+}
+
+func (handler *HighlightHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookHighlights{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get highlights")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get highlights")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsGetHighlights{
+		Type:     r.URL.Query().Get(queryType),
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	highlightsResponse, meta, err := handler.HighlightService.GetAllHighlights(r.Context(), pathValue, queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get highlights")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all highlights",
+		Data:    highlightsResponse,
+		Meta:    meta,
+	})
+}
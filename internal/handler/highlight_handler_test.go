@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockHighlightService struct {
+	// GetAllHighlights
+	MockPathValue          web.PathParamsBookHighlights
+	GetAllMockQuery        web.QueryParamsGetHighlights
+	HighlightsMockResponse []web.HighlightResponse
+	MockMeta               web.PaginationMeta
+
+	MockError error
+}
+
+func (m *MockHighlightService) GetAllHighlights(ctx context.Context, pathValues web.PathParamsBookHighlights, queries web.QueryParamsGetHighlights) ([]web.HighlightResponse, web.PaginationMeta, error) {
+	m.MockPathValue = pathValues
+	m.GetAllMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.HighlightsMockResponse, m.MockMeta, nil
+}
+
+func TestHighlightGetAllHandler(t *testing.T) {
+	t.Run("get highlights of a book", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetHighlights{
+			Type: "highlight",
+			Sort: "-clipped_at",
+		}
+
+		mockService := &MockHighlightService{
+			HighlightsMockResponse: []web.HighlightResponse{
+				{
+					Id:       "9b1c2d3e-4f5a-4b6c-8d7e-0f1a2b3c4d5e",
+					BookId:   "43723811-c8e3-4cba-85cc-142954064ae4",
+					Type:     "highlight",
+					Text:     "Fear is the mind-killer.",
+					Location: "120-121",
+					Page:     8,
+				},
+			},
+		}
+
+		handler := NewHighlightHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/highlights?type=highlight&sort=-clipped_at", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != 1 {
+			t.Fatalf("expected 1 highlight but got %v", actualResponseBody.Data)
+		}
+
+		val, ok := dataList[0].(map[string]interface{})
+		if ok {
+			if val["location"] != "120-121" {
+				t.Errorf("expected location '120-121' but got '%v'", val["location"])
+			}
+
+			if val["clipped_at"] != nil {
+				t.Errorf("expected clipped_at null but got %v", val["clipped_at"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual queries and path values that has been passed to service
+		if !reflect.DeepEqual(mockService.GetAllMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.GetAllMockQuery)
+		}
+
+		if mockService.MockPathValue.BookId != "43723811-c8e3-4cba-85cc-142954064ae4" {
+			t.Errorf("expected id %s but got %s", "43723811-c8e3-4cba-85cc-142954064ae4", mockService.MockPathValue.BookId)
+		}
+	})
+
+	t.Run("get highlights with quote type", func(t *testing.T) {
+		queries := web.QueryParamsGetHighlights{
+			Type: "quote",
+		}
+
+		mockService := &MockHighlightService{
+			MockError: config.ValidatorInit().Struct(queries),
+		}
+
+		handler := NewHighlightHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/highlights?type=quote", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package handler
+
+import "net/http"
+
+type ImportHandler interface {
+	ImportBooks(w http.ResponseWriter, r *http.Request)
+	ImportKindleClippings(w http.ResponseWriter, r *http.Request)
+	ImportKoboAnnotations(w http.ResponseWriter, r *http.Request)
+}
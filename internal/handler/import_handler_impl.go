@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const (
+	queryBatchSize        = "batch_size"
+	queryDefaultTotalPage = "default_total_page"
+
+	// importFormField is the multipart field holding the uploaded file
+	importFormField = "file"
+
+	// maxImportSize limits the uploaded file to 10 MB
+	maxImportSize = 10 << 20
+)
+
+func NewImportHandler(importService service.ImportService) ImportHandler {
+	return &ImportHandlerImpl{
+		ImportService: importService,
+	}
+}
+
+type ImportHandlerImpl struct {
+	ImportService service.ImportService
+}
+
+func (handler *ImportHandlerImpl) ImportBooks(w http.ResponseWriter, r *http.Request) {
+	// Get import query params if any
+	batchSize, err := readIntQuery(r, queryBatchSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import books")
+		return
+	}
+
+	defaultTotalPage, err := readIntQuery(r, queryDefaultTotalPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import books")
+		return
+	}
+
+	queries := web.QueryParamsImportBooks{
+		BatchSize:        batchSize,
+		DefaultTotalPage: defaultTotalPage,
+	}
+
+	// Get the uploaded CSV
+	file, err := readImportFile(w, r)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import books")
+		return
+	}
+	defer file.Close()
+
+	// Call the service
+	importResponse, err := handler.ImportService.ImportBooks(r.Context(), queries, file)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import books")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Books imported successfully",
+		Data:    importResponse,
+	})
+}
+
+func (handler *ImportHandlerImpl) ImportKindleClippings(w http.ResponseWriter, r *http.Request) {
+	// Get the uploaded My Clippings.txt
+	file, err := readImportFile(w, r)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import clippings")
+		return
+	}
+	defer file.Close()
+
+	// Call the service
+	importResponse, err := handler.ImportService.ImportKindleClippings(r.Context(), file)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import clippings")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Clippings imported successfully",
+		Data:    importResponse,
+	})
+}
+
+func (handler *ImportHandlerImpl) ImportKoboAnnotations(w http.ResponseWriter, r *http.Request) {
+	// Get the uploaded .annot file
+	file, err := readImportFile(w, r)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import annotations")
+		return
+	}
+	defer file.Close()
+
+	// Call the service
+	importResponse, err := handler.ImportService.ImportKoboAnnotations(r.Context(), file)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to import annotations")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Annotations imported successfully",
+		Data:    importResponse,
+	})
+}
+
+// readImportFile returns the file to import, it is either sent as a multipart file or as the raw request body
+func readImportFile(w http.ResponseWriter, r *http.Request) (io.ReadCloser, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return r.Body, nil
+	}
+
+	formFile, _, err := r.FormFile(importFormField)
+	if err != nil {
+		return nil, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   importFormField,
+					Message: "file is required",
+				},
+			},
+			err,
+		)
+	}
+
+	return formFile, nil
+}
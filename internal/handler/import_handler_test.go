@@ -0,0 +1,468 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+const goodreadsCSV = `Book Id,Title,Author,Number of Pages,Exclusive Shelf,Date Read
+1,Dune,Frank Herbert,412,read,2024/01/05
+`
+
+const kindleClippings = `Dune (Herbert, Frank)
+- Your Highlight on page 8 | Location 120-121 | Added on Sunday, 5 March 2023 10:11:12
+
+Fear is the mind-killer.
+==========
+`
+
+const koboAnnotations = `<annotationSet xmlns="http://ns.adobe.com/digitaleditions/annotations" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <publication><dc:title>Dune</dc:title><dc:creator>Frank Herbert</dc:creator></publication>
+  <annotation><target><fragment progress="0.02"><text>Fear is the mind-killer.</text></fragment></target></annotation>
+</annotationSet>
+`
+
+type MockImportService struct {
+	// ImportBooks
+	ImportCalledWithQueries web.QueryParamsImportBooks
+	ImportCalledWithFile    string
+	MockImportResponse      web.ImportBooksResponse
+
+	// ImportKindleClippings and ImportKoboAnnotations
+	ClippingsCalledWithFile     string
+	AnnotationsCalledWithFile   string
+	MockImportClippingsResponse web.ImportHighlightsResponse
+
+	MockError error
+}
+
+func (m *MockImportService) ImportBooks(ctx context.Context, queries web.QueryParamsImportBooks, file io.Reader) (web.ImportBooksResponse, error) {
+	m.ImportCalledWithQueries = queries
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return web.ImportBooksResponse{}, err
+	}
+	m.ImportCalledWithFile = string(content)
+
+	if m.MockError != nil {
+		return web.ImportBooksResponse{}, m.MockError
+	}
+
+	return m.MockImportResponse, nil
+}
+
+func (m *MockImportService) ImportKindleClippings(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return web.ImportHighlightsResponse{}, err
+	}
+	m.ClippingsCalledWithFile = string(content)
+
+	if m.MockError != nil {
+		return web.ImportHighlightsResponse{}, m.MockError
+	}
+
+	return m.MockImportClippingsResponse, nil
+}
+
+func (m *MockImportService) ImportKoboAnnotations(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error) {
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return web.ImportHighlightsResponse{}, err
+	}
+	m.AnnotationsCalledWithFile = string(content)
+
+	if m.MockError != nil {
+		return web.ImportHighlightsResponse{}, m.MockError
+	}
+
+	return m.MockImportClippingsResponse, nil
+}
+
+func TestImportBooksHandler(t *testing.T) {
+	expectedServiceResponse := web.ImportBooksResponse{
+		Format:  "goodreads",
+		Created: 1,
+		Rows: []web.ImportRowResponse{
+			{
+				Line:       2,
+				Title:      "Dune",
+				AuthorName: "Frank Herbert",
+				Result:     web.ImportResultCreated,
+				BookId:     "43723811-c8e3-4cba-85cc-142954064ae4",
+				AuthorId:   "7d8a3f1c-2b4e-4f6a-9c1d-0e5b6a7c8d9f",
+			},
+		},
+	}
+
+	t.Run("import books from raw csv body", func(t *testing.T) {
+		mockService := &MockImportService{
+			MockImportResponse: expectedServiceResponse,
+		}
+
+		handler := NewImportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/import?batch_size=50&default_total_page=300", strings.NewReader(goodreadsCSV))
+		req.Header.Set("Content-Type", "text/csv")
+		res := httptest.NewRecorder()
+
+		handler.ImportBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["format"] != expectedServiceResponse.Format {
+				t.Errorf("expected format '%s' but got '%s'", expectedServiceResponse.Format, val["format"])
+			}
+
+			if int(val["created"].(float64)) != expectedServiceResponse.Created {
+				t.Errorf("expected created '%d' but got '%v'", expectedServiceResponse.Created, val["created"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual queries and file that has been passed to service
+		expectedQueries := web.QueryParamsImportBooks{
+			BatchSize:        50,
+			DefaultTotalPage: 300,
+		}
+		if !reflect.DeepEqual(mockService.ImportCalledWithQueries, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.ImportCalledWithQueries)
+		}
+
+		if mockService.ImportCalledWithFile != goodreadsCSV {
+			t.Errorf("expected %q as file but got %q", goodreadsCSV, mockService.ImportCalledWithFile)
+		}
+	})
+
+	t.Run("import books from multipart file", func(t *testing.T) {
+		mockService := &MockImportService{
+			MockImportResponse: expectedServiceResponse,
+		}
+
+		handler := NewImportHandler(mockService)
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "goodreads_library_export.csv")
+		if err != nil {
+			t.Fatalf("error when creating form file: %v", err)
+		}
+		part.Write([]byte(goodreadsCSV))
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/import", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		res := httptest.NewRecorder()
+
+		handler.ImportBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		if mockService.ImportCalledWithFile != goodreadsCSV {
+			t.Errorf("expected %q as file but got %q", goodreadsCSV, mockService.ImportCalledWithFile)
+		}
+	})
+
+	t.Run("import books with invalid query params", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			URL        string
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "batch_size is not a number",
+				URL:        "/api/v1/books/import?batch_size=all",
+				ErrField:   "batch_size",
+				ErrMessage: "batch_size must be a number",
+			},
+			{
+				Name:       "default_total_page is not a number",
+				URL:        "/api/v1/books/import?default_total_page=many",
+				ErrField:   "default_total_page",
+				ErrMessage: "default_total_page must be a number",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockImportService{}
+
+				handler := NewImportHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, c.URL, strings.NewReader(goodreadsCSV))
+				res := httptest.NewRecorder()
+
+				handler.ImportBooks(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+
+	t.Run("import books with multipart form without file", func(t *testing.T) {
+		mockService := &MockImportService{}
+
+		handler := NewImportHandler(mockService)
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		writer.WriteField("note", "no file here")
+		writer.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/import", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		res := httptest.NewRecorder()
+
+		handler.ImportBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
+
+func TestImportKindleClippingsHandler(t *testing.T) {
+	t.Run("import clippings from raw body", func(t *testing.T) {
+		expectedServiceResponse := web.ImportHighlightsResponse{
+			Format:     "kindle",
+			Imported:   1,
+			Duplicates: 2,
+			Books: []web.ImportHighlightsBookResponse{
+				{
+					Title:      "Dune",
+					AuthorName: "Frank Herbert",
+					BookId:     "43723811-c8e3-4cba-85cc-142954064ae4",
+					BookName:   "Dune",
+					Imported:   1,
+					Duplicates: 2,
+				},
+			},
+			Unmatched: []web.UnmatchedClippingsResponse{
+				{
+					Title:      "Sapiens: A Brief History of Humankind",
+					AuthorName: "Yuval Noah Harari",
+					Clippings:  4,
+					Book: web.CreateBookRequest{
+						Name:   "Sapiens: A Brief History of Humankind",
+						Status: "plan_to_read",
+					},
+				},
+			},
+		}
+
+		mockService := &MockImportService{
+			MockImportClippingsResponse: expectedServiceResponse,
+		}
+
+		handler := NewImportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/highlights/import/kindle", strings.NewReader(kindleClippings))
+		req.Header.Set("Content-Type", "text/plain")
+		res := httptest.NewRecorder()
+
+		handler.ImportKindleClippings(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if int(val["duplicates"].(float64)) != expectedServiceResponse.Duplicates {
+				t.Errorf("expected duplicates '%d' but got '%v'", expectedServiceResponse.Duplicates, val["duplicates"])
+			}
+
+			unmatched, ok := val["unmatched"].([]interface{})
+			if !ok || len(unmatched) != 1 {
+				t.Errorf("expected 1 unmatched title but got %v", val["unmatched"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual file that has been passed to service
+		if mockService.ClippingsCalledWithFile != kindleClippings {
+			t.Errorf("expected %q as file but got %q", kindleClippings, mockService.ClippingsCalledWithFile)
+		}
+	})
+
+	t.Run("import file that is not my clippings", func(t *testing.T) {
+		mockService := &MockImportService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "file",
+						Message: "file is not a Kindle My Clippings.txt file",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewImportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/highlights/import/kindle", strings.NewReader(goodreadsCSV))
+		res := httptest.NewRecorder()
+
+		handler.ImportKindleClippings(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
+
+func TestImportKoboAnnotationsHandler(t *testing.T) {
+	t.Run("import annotations from raw body", func(t *testing.T) {
+		expectedServiceResponse := web.ImportHighlightsResponse{
+			Format:   "kobo",
+			Imported: 1,
+			Books: []web.ImportHighlightsBookResponse{
+				{
+					Title:      "Dune",
+					AuthorName: "Frank Herbert",
+					BookId:     "43723811-c8e3-4cba-85cc-142954064ae4",
+					BookName:   "Dune",
+					Imported:   1,
+				},
+			},
+			Unmatched: []web.UnmatchedClippingsResponse{},
+		}
+
+		mockService := &MockImportService{
+			MockImportClippingsResponse: expectedServiceResponse,
+		}
+
+		handler := NewImportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/highlights/import/kobo", strings.NewReader(koboAnnotations))
+		req.Header.Set("Content-Type", "application/xml")
+		res := httptest.NewRecorder()
+
+		handler.ImportKoboAnnotations(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["format"] != expectedServiceResponse.Format {
+				t.Errorf("expected format '%s' but got '%v'", expectedServiceResponse.Format, val["format"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual file that has been passed to service
+		if mockService.AnnotationsCalledWithFile != koboAnnotations {
+			t.Errorf("expected %q as file but got %q", koboAnnotations, mockService.AnnotationsCalledWithFile)
+		}
+	})
+
+	t.Run("import file that is not an annotation export", func(t *testing.T) {
+		mockService := &MockImportService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "file",
+						Message: "file is not a Kobo annotation export",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewImportHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/highlights/import/kobo", strings.NewReader(kindleClippings))
+		res := httptest.NewRecorder()
+
+		handler.ImportKoboAnnotations(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
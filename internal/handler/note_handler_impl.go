@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const wildcardNoteId = "note_id"
+
+func NewNoteHandler(noteService service.NoteService) NoteHandler {
+	return &NoteHandlerImpl{
+		NoteService: noteService,
+	}
+}
+
+type NoteHandlerImpl struct {
+	NoteService service.NoteService
+}
+
+func (handler *NoteHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookNotes{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to noteRequest
+	noteRequest := web.CreateNoteRequest{}
+	err := helper.ReadFromRequestBody(r, &noteRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	noteResponse, err := handler.NoteService.CreateNewNote(r.Context(), pathValue, noteRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new note")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Note created successfully",
+		Data:    noteResponse,
+	})
+}
+
+func (handler *NoteHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookNotes{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get notes")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get notes")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsGetNotes{
+		Type:     r.URL.Query().Get(queryType),
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	notesResponse, meta, err := handler.NoteService.GetAllNotes(r.Context(), pathValue, queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get notes")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all notes",
+		Data:    notesResponse,
+		Meta:    meta,
+	})
+}
+
+func (handler *NoteHandlerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookNote{
+		BookId: r.PathValue(wildcardId),
+		NoteId: r.PathValue(wildcardNoteId),
+	}
+
+	// Call the service
+	noteResponse, err := handler.NoteService.GetNoteById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get note by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get note",
+		Data:    noteResponse,
+	})
+}
+
+func (handler *NoteHandlerImpl) UpdateById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookNote{
+		BookId: r.PathValue(wildcardId),
+		NoteId: r.PathValue(wildcardNoteId),
+	}
+
+	// Get request body and write it to noteRequest
+	noteRequest := web.UpdateNoteRequest{}
+	err := helper.ReadFromRequestBody(r, &noteRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	noteResponse, err := handler.NoteService.UpdateNoteById(r.Context(), pathValue, noteRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to update note by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Note updated successfully",
+		Data:    noteResponse,
+	})
+}
+
+func (handler *NoteHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookNote{
+		BookId: r.PathValue(wildcardId),
+		NoteId: r.PathValue(wildcardNoteId),
+	}
+
+	// Call the service
+	err := handler.NoteService.DeleteNoteById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete note by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *NoteHandlerImpl) Search(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search notes")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search notes")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsSearchNotes{
+		Query:    r.URL.Query().Get(querySearch),
+		Type:     r.URL.Query().Get(queryType),
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Call the service
+	resultsResponse, meta, err := handler.NoteService.SearchNotes(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search notes")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success search notes",
+		Data:    resultsResponse,
+		Meta:    meta,
+	})
+}
@@ -0,0 +1,405 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockNoteService struct {
+	// CreateNewNote and GetAllNotes
+	NotesMockPathValue web.PathParamsBookNotes
+	CreateMockRequest  web.CreateNoteRequest
+	GetAllMockQuery    web.QueryParamsGetNotes
+	NotesMockResponse  []web.NoteResponse
+
+	// GetNoteById, UpdateNoteById and DeleteNoteById
+	NoteMockPathValue web.PathParamsBookNote
+	UpdateMockRequest web.UpdateNoteRequest
+	NoteMockResponse  web.NoteResponse
+
+	// SearchNotes
+	SearchMockQuery    web.QueryParamsSearchNotes
+	SearchMockResponse []web.NoteSearchResultResponse
+
+	MockMeta  web.PaginationMeta
+	MockError error
+}
+
+func (m *MockNoteService) CreateNewNote(ctx context.Context, pathValues web.PathParamsBookNotes, request web.CreateNoteRequest) (web.NoteResponse, error) {
+	m.NotesMockPathValue = pathValues
+	m.CreateMockRequest = request
+
+	if m.MockError != nil {
+		return web.NoteResponse{}, m.MockError
+	}
+
+	return m.NoteMockResponse, nil
+}
+
+func (m *MockNoteService) GetAllNotes(ctx context.Context, pathValues web.PathParamsBookNotes, queries web.QueryParamsGetNotes) ([]web.NoteResponse, web.PaginationMeta, error) {
+	m.NotesMockPathValue = pathValues
+	m.GetAllMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.NotesMockResponse, m.MockMeta, nil
+}
+
+func (m *MockNoteService) GetNoteById(ctx context.Context, pathValues web.PathParamsBookNote) (web.NoteResponse, error) {
+	m.NoteMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.NoteResponse{}, m.MockError
+	}
+
+	return m.NoteMockResponse, nil
+}
+
+func (m *MockNoteService) UpdateNoteById(ctx context.Context, pathValues web.PathParamsBookNote, request web.UpdateNoteRequest) (web.NoteResponse, error) {
+	m.NoteMockPathValue = pathValues
+	m.UpdateMockRequest = request
+
+	if m.MockError != nil {
+		return web.NoteResponse{}, m.MockError
+	}
+
+	return m.NoteMockResponse, nil
+}
+
+func (m *MockNoteService) DeleteNoteById(ctx context.Context, pathValues web.PathParamsBookNote) error {
+	m.NoteMockPathValue = pathValues
+
+	return m.MockError
+}
+
+func (m *MockNoteService) SearchNotes(ctx context.Context, queries web.QueryParamsSearchNotes) ([]web.NoteSearchResultResponse, web.PaginationMeta, error) {
+	m.SearchMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.SearchMockResponse, m.MockMeta, nil
+}
+
+func TestNoteCreateHandler(t *testing.T) {
+	t.Run("create highlight", func(t *testing.T) {
+		noteRequest := web.CreateNoteRequest{
+			Type: "highlight",
+			Text: "Kita tidak pernah benar-benar pergi.",
+			Page: 112,
+		}
+		expectedServiceResponse := web.NoteResponse{
+			Id:     "5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f",
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			Type:   "highlight",
+			Text:   "Kita tidak pernah benar-benar pergi.",
+			Page:   112,
+		}
+
+		mockService := &MockNoteService{
+			NoteMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewNoteHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/notes", ToJSON(noteRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["type"] != expectedServiceResponse.Type {
+				t.Errorf("expected type '%s' but got '%s'", expectedServiceResponse.Type, val["type"])
+			}
+
+			if val["page"] != float64(expectedServiceResponse.Page) {
+				t.Errorf("expected page %d but got %v", expectedServiceResponse.Page, val["page"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual request body and path values that has been passed to service
+		if !reflect.DeepEqual(mockService.CreateMockRequest, noteRequest) {
+			t.Errorf("expected %+v as request body but got %+v", noteRequest, mockService.CreateMockRequest)
+		}
+
+		if mockService.NotesMockPathValue.BookId != "43723811-c8e3-4cba-85cc-142954064ae4" {
+			t.Errorf("expected id %s but got %s", "43723811-c8e3-4cba-85cc-142954064ae4", mockService.NotesMockPathValue.BookId)
+		}
+	})
+
+	t.Run("create note with invalid request", func(t *testing.T) {
+		cases := []struct {
+			Name        string
+			NoteRequest web.CreateNoteRequest
+			ErrField    string
+			ErrMessage  string
+		}{
+			{
+				Name: "unknown type",
+				NoteRequest: web.CreateNoteRequest{
+					Type: "bookmark",
+					Text: "Chapter 3",
+				},
+				ErrField:   "type",
+				ErrMessage: "the valid value for this field are only 'note', 'highlight', and 'quote'",
+			},
+			{
+				Name: "empty text",
+				NoteRequest: web.CreateNoteRequest{
+					Type: "note",
+				},
+				ErrField:   "text",
+				ErrMessage: "text is required",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockNoteService{
+					MockError: validate.Struct(c.NoteRequest),
+				}
+
+				handler := NewNoteHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/notes", ToJSON(c.NoteRequest))
+				res := httptest.NewRecorder()
+
+				// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+				req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+
+	t.Run("create note with page beyond the book", func(t *testing.T) {
+		mockService := &MockNoteService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "page",
+						Message: "page must not be greater than total_page (320)",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewNoteHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/notes", ToJSON(web.CreateNoteRequest{
+			Type: "quote",
+			Text: "Matilah engkau mati.",
+			Page: 999,
+		}))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
+
+func TestNoteGetAllHandler(t *testing.T) {
+	t.Run("get highlights sorted by page", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetNotes{
+			Type: "highlight",
+			Page: 2,
+			Sort: "-page",
+		}
+
+		mockService := &MockNoteService{
+			NotesMockResponse: []web.NoteResponse{
+				{
+					Id:   "5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f",
+					Type: "highlight",
+					Page: 112,
+				},
+			},
+		}
+
+		handler := NewNoteHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/notes?type=highlight&page=2&sort=-page", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.GetAllMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.GetAllMockQuery)
+		}
+	})
+}
+
+func TestNoteDeleteByIdHandler(t *testing.T) {
+	t.Run("delete note", func(t *testing.T) {
+		mockService := &MockNoteService{}
+
+		handler := NewNoteHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/notes/5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+		req.SetPathValue("note_id", "5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f")
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNoContent {
+			t.Errorf("expected status code of %d but got %d", http.StatusNoContent, res.Code)
+		}
+
+		expectedPathValue := web.PathParamsBookNote{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			NoteId: "5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f",
+		}
+		if mockService.NoteMockPathValue != expectedPathValue {
+			t.Errorf("expected %+v as path values but got %+v", expectedPathValue, mockService.NoteMockPathValue)
+		}
+	})
+}
+
+func TestNoteSearchHandler(t *testing.T) {
+	t.Run("search notes", func(t *testing.T) {
+		expectedQueries := web.QueryParamsSearchNotes{
+			Query: "pergi",
+			Type:  "quote",
+		}
+		expectedServiceResponse := []web.NoteSearchResultResponse{
+			{
+				Id:       "5f0e2a9c-8d3b-4c1e-9a7f-6b2d4e8c1a3f",
+				BookId:   "43723811-c8e3-4cba-85cc-142954064ae4",
+				BookName: "Laut Bercerita",
+				Type:     "quote",
+				Page:     112,
+				Snippet:  "Kita tidak pernah benar-benar <mark>pergi</mark>.",
+				Rank:     0.6,
+			},
+		}
+
+		mockService := &MockNoteService{
+			SearchMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewNoteHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/notes/search?q=pergi&type=quote", nil)
+		res := httptest.NewRecorder()
+
+		handler.Search(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != 1 {
+			t.Fatalf("expected 1 result but got %v", actualResponseBody.Data)
+		}
+
+		val, ok := dataList[0].(map[string]interface{})
+		if ok {
+			if val["book_name"] != expectedServiceResponse[0].BookName {
+				t.Errorf("expected book_name '%s' but got '%s'", expectedServiceResponse[0].BookName, val["book_name"])
+			}
+
+			if val["snippet"] != expectedServiceResponse[0].Snippet {
+				t.Errorf("expected snippet '%s' but got '%s'", expectedServiceResponse[0].Snippet, val["snippet"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.SearchMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.SearchMockQuery)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+)
+
+const (
+	queryPage     = "page"
+	queryPageSize = "page_size"
+	querySort     = "sort"
+)
+
+// readIntQuery reads an optional integer query param, a missing param is read as 0
+func readIntQuery(r *http.Request, key string) (int, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   key,
+					Message: fmt.Sprintf("%s must be a number", key),
+				},
+			},
+			err,
+		)
+	}
+
+	return number, nil
+}
+
+// readFloatQuery reads an optional decimal query param, a missing param is read as 0
+func readFloatQuery(r *http.Request, key string) (float64, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   key,
+					Message: fmt.Sprintf("%s must be a number", key),
+				},
+			},
+			err,
+		)
+	}
+
+	return number, nil
+}
+
+// readListQuery reads an optional list query param given either as tags=a,b or as tags=a&tags=b,
+// a missing param is read as nil
+func readListQuery(r *http.Request, key string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[key] {
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,10 @@
+package handler
+
+import "net/http"
+
+type ReadHandler interface {
+	Start(w http.ResponseWriter, r *http.Request)
+	Finish(w http.ResponseWriter, r *http.Request)
+	Abandon(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const wildcardReadId = "read_id"
+
+func NewReadHandler(readService service.ReadService) ReadHandler {
+	return &ReadHandlerImpl{
+		ReadService: readService,
+	}
+}
+
+type ReadHandlerImpl struct {
+	ReadService service.ReadService
+}
+
+func (handler *ReadHandlerImpl) Start(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReads{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to readRequest
+	readRequest := web.StartReadRequest{}
+	err := helper.ReadFromRequestBody(r, &readRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	readResponse, err := handler.ReadService.StartRead(r.Context(), pathValue, readRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to start read")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Read started successfully",
+		Data:    readResponse,
+	})
+}
+
+func (handler *ReadHandlerImpl) Finish(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookRead{
+		BookId: r.PathValue(wildcardId),
+		ReadId: r.PathValue(wildcardReadId),
+	}
+
+	// Get request body and write it to readRequest
+	readRequest := web.EndReadRequest{}
+	err := helper.ReadFromRequestBody(r, &readRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	readResponse, err := handler.ReadService.FinishRead(r.Context(), pathValue, readRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to finish read")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Read finished successfully",
+		Data:    readResponse,
+	})
+}
+
+func (handler *ReadHandlerImpl) Abandon(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookRead{
+		BookId: r.PathValue(wildcardId),
+		ReadId: r.PathValue(wildcardReadId),
+	}
+
+	// Get request body and write it to readRequest
+	readRequest := web.EndReadRequest{}
+	err := helper.ReadFromRequestBody(r, &readRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	readResponse, err := handler.ReadService.AbandonRead(r.Context(), pathValue, readRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to abandon read")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Read abandoned successfully",
+		Data:    readResponse,
+	})
+}
+
+func (handler *ReadHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReads{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	readsResponse, err := handler.ReadService.GetAllReads(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get reads")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all reads",
+		Data:    readsResponse,
+	})
+}
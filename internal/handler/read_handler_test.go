@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockReadService struct {
+	// StartRead and GetAllReads
+	MockBookPathValue web.PathParamsBookReads
+	StartMockRequest  web.StartReadRequest
+
+	// FinishRead and AbandonRead
+	MockReadPathValue web.PathParamsBookRead
+	EndMockRequest    web.EndReadRequest
+	EndMockOutcome    string
+
+	ChangeMockResponse web.ChangeReadResponse
+	ReadsMockResponse  []web.ReadResponse
+
+	MockError error
+}
+
+func (m *MockReadService) StartRead(ctx context.Context, pathValues web.PathParamsBookReads, request web.StartReadRequest) (web.ChangeReadResponse, error) {
+	m.MockBookPathValue = pathValues
+	m.StartMockRequest = request
+
+	if m.MockError != nil {
+		return web.ChangeReadResponse{}, m.MockError
+	}
+
+	return m.ChangeMockResponse, nil
+}
+
+func (m *MockReadService) FinishRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error) {
+	m.MockReadPathValue = pathValues
+	m.EndMockRequest = request
+	m.EndMockOutcome = "finished"
+
+	if m.MockError != nil {
+		return web.ChangeReadResponse{}, m.MockError
+	}
+
+	return m.ChangeMockResponse, nil
+}
+
+func (m *MockReadService) AbandonRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error) {
+	m.MockReadPathValue = pathValues
+	m.EndMockRequest = request
+	m.EndMockOutcome = "abandoned"
+
+	if m.MockError != nil {
+		return web.ChangeReadResponse{}, m.MockError
+	}
+
+	return m.ChangeMockResponse, nil
+}
+
+func (m *MockReadService) GetAllReads(ctx context.Context, pathValues web.PathParamsBookReads) ([]web.ReadResponse, error) {
+	m.MockBookPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.ReadsMockResponse, nil
+}
+
+func TestReadStartHandler(t *testing.T) {
+	t.Run("start a re-read", func(t *testing.T) {
+		readRequest := web.StartReadRequest{
+			StartedDate: "2026-03-01",
+		}
+		expectedServiceResponse := web.ChangeReadResponse{
+			Read: web.ReadResponse{
+				Id:          "9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d",
+				BookId:      "43723811-c8e3-4cba-85cc-142954064ae4",
+				StartedDate: "2026-03-01",
+			},
+			CurrentPage: 0,
+			Status:      "reading",
+		}
+
+		mockService := &MockReadService{
+			ChangeMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewReadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/reads", ToJSON(readRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Start(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["status"] != expectedServiceResponse.Status {
+				t.Errorf("expected status '%s' but got '%s'", expectedServiceResponse.Status, val["status"])
+			}
+
+			read, ok := val["read"].(map[string]interface{})
+			if !ok {
+				t.Fatal("read should be true but got false")
+			}
+
+			if _, ok := read["outcome"]; ok {
+				t.Errorf("expected no outcome for a read in progress but got %v", read["outcome"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path value and request body that has been passed to service
+		if mockService.MockBookPathValue.BookId != "43723811-c8e3-4cba-85cc-142954064ae4" {
+			t.Errorf("expected book id '43723811-c8e3-4cba-85cc-142954064ae4' but got '%s'", mockService.MockBookPathValue.BookId)
+		}
+
+		if !reflect.DeepEqual(mockService.StartMockRequest, readRequest) {
+			t.Errorf("expected %+v as request body but got %+v", readRequest, mockService.StartMockRequest)
+		}
+	})
+
+	t.Run("start a read while another is in progress", func(t *testing.T) {
+		mockService := &MockReadService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: "book with id '43723811-c8e3-4cba-85cc-142954064ae4' already has a read in progress",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewReadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/reads", ToJSON(web.StartReadRequest{}))
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Start(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
+
+func TestReadFinishHandler(t *testing.T) {
+	t.Run("finish a read with a rating", func(t *testing.T) {
+		readRequest := web.EndReadRequest{
+			FinishedDate: "2026-03-20",
+			Rating:       4.5,
+		}
+		expectedPathValue := web.PathParamsBookRead{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			ReadId: "9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d",
+		}
+		expectedServiceResponse := web.ChangeReadResponse{
+			Read: web.ReadResponse{
+				Id:           "9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d",
+				BookId:       "43723811-c8e3-4cba-85cc-142954064ae4",
+				StartedDate:  "2026-03-01",
+				FinishedDate: "2026-03-20",
+				Outcome:      "finished",
+				Rating:       4.5,
+			},
+			CurrentPage:   379,
+			Status:        "completed",
+			CompletedDate: "2026-03-20",
+		}
+
+		mockService := &MockReadService{
+			ChangeMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewReadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/reads/9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d/finish", ToJSON(readRequest))
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", expectedPathValue.BookId)
+		req.SetPathValue("read_id", expectedPathValue.ReadId)
+
+		handler.Finish(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["completed_date"] != expectedServiceResponse.CompletedDate {
+				t.Errorf("expected completed_date '%s' but got '%s'", expectedServiceResponse.CompletedDate, val["completed_date"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path value and request body that has been passed to service
+		if !reflect.DeepEqual(mockService.MockReadPathValue, expectedPathValue) {
+			t.Errorf("expected %+v as path value but got %+v", expectedPathValue, mockService.MockReadPathValue)
+		}
+
+		if !reflect.DeepEqual(mockService.EndMockRequest, readRequest) || mockService.EndMockOutcome != "finished" {
+			t.Errorf("expected %+v to finish the read but got %+v to %s it", readRequest, mockService.EndMockRequest, mockService.EndMockOutcome)
+		}
+	})
+
+	t.Run("finish a read with invalid request", func(t *testing.T) {
+		cases := []struct {
+			Name        string
+			ReadRequest web.EndReadRequest
+			ErrField    string
+			ErrMessage  string
+		}{
+			{
+				Name:        "invalid finished_date",
+				ReadRequest: web.EndReadRequest{FinishedDate: "20-03-2026"},
+				ErrField:    "finished_date",
+				ErrMessage:  "use YYYY-MM-DD for valid datetime",
+			},
+			{
+				Name:        "invalid rating step",
+				ReadRequest: web.EndReadRequest{Rating: 4.2},
+				ErrField:    "rating",
+				ErrMessage:  "rating must be between 0.5 and 5 in steps of 0.5",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockReadService{
+					MockError: validate.Struct(c.ReadRequest),
+				}
+
+				handler := NewReadHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/reads/9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d/finish", ToJSON(c.ReadRequest))
+				res := httptest.NewRecorder()
+
+				handler.Finish(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestReadAbandonHandler(t *testing.T) {
+	t.Run("abandon a read that is not found", func(t *testing.T) {
+		mockService := &MockReadService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "read_id",
+						Message: "read with id '9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d' is not found",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewReadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/reads/9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d/abandon", ToJSON(web.EndReadRequest{}))
+		res := httptest.NewRecorder()
+
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+		req.SetPathValue("read_id", "9d3c2b1a-8e7f-4a6b-9c5d-4e3f2a1b0c9d")
+
+		handler.Abandon(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+
+		// Check the outcome that has been passed to service
+		if mockService.EndMockOutcome != "abandoned" {
+			t.Errorf("expected the read to be abandoned but got %s", mockService.EndMockOutcome)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+func NewReadingSessionHandler(readingSessionService service.ReadingSessionService) ReadingSessionHandler {
+	return &ReadingSessionHandlerImpl{
+		ReadingSessionService: readingSessionService,
+	}
+}
+
+type ReadingSessionHandlerImpl struct {
+	ReadingSessionService service.ReadingSessionService
+}
+
+func (handler *ReadingSessionHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsCreateReadingSession{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to sessionRequest
+	sessionRequest := web.CreateReadingSessionRequest{}
+	err := helper.ReadFromRequestBody(r, &sessionRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	sessionResponse, err := handler.ReadingSessionService.CreateNewSession(r.Context(), pathValue, sessionRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new reading session")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Reading session created successfully",
+		Data:    sessionResponse,
+	})
+}
+
+func (handler *ReadingSessionHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGetReadingSessions{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	sessionsResponse, err := handler.ReadingSessionService.GetAllSessions(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get reading sessions")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all reading sessions",
+		Data:    sessionsResponse,
+	})
+}
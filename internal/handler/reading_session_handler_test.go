@@ -0,0 +1,402 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockReadingSessionService struct {
+	// CreateNewSession
+	CreateCalledWithPathValue web.PathParamsCreateReadingSession
+	CreateCalledWithRequest   web.CreateReadingSessionRequest
+	MockCreateResponse        web.CreateReadingSessionResponse
+
+	// GetAllSessions
+	GetAllCalledWithPathValue web.PathParamsGetReadingSessions
+	MockGetAllResponse        []web.ReadingSessionResponse
+
+	MockError error
+}
+
+func (m *MockReadingSessionService) CreateNewSession(ctx context.Context, pathValues web.PathParamsCreateReadingSession, request web.CreateReadingSessionRequest) (web.CreateReadingSessionResponse, error) {
+	m.CreateCalledWithPathValue = pathValues
+	m.CreateCalledWithRequest = request
+
+	if m.MockError != nil {
+		return web.CreateReadingSessionResponse{}, m.MockError
+	}
+
+	return m.MockCreateResponse, nil
+}
+
+func (m *MockReadingSessionService) GetAllSessions(ctx context.Context, pathValues web.PathParamsGetReadingSessions) ([]web.ReadingSessionResponse, error) {
+	m.GetAllCalledWithPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.MockGetAllResponse, nil
+}
+
+func TestReadingSessionCreateHandler(t *testing.T) {
+	t.Run("create reading session that completes the book", func(t *testing.T) {
+		pathValue := web.PathParamsCreateReadingSession{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+		}
+		sessionRequest := web.CreateReadingSessionRequest{
+			SessionDate: "2025-09-29",
+			StartPage:   350,
+			EndPage:     379,
+			MinutesRead: 45,
+		}
+		expectedServiceResponse := web.CreateReadingSessionResponse{
+			Session: web.ReadingSessionResponse{
+				Id:          "5b0f7e0a-3f58-4a36-9a3c-7a8d8f0f2d11",
+				BookId:      "43723811-c8e3-4cba-85cc-142954064ae4",
+				SessionDate: "2025-09-29",
+				StartPage:   350,
+				EndPage:     379,
+				MinutesRead: 45,
+			},
+			CurrentPage:   379,
+			Status:        "completed",
+			CompletedDate: "2025-09-29",
+		}
+
+		mockService := &MockReadingSessionService{
+			MockCreateResponse: expectedServiceResponse,
+		}
+
+		handler := NewReadingSessionHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/sessions", ToJSON(sessionRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body message
+		if actualResponseBody.Message != "Reading session created successfully" {
+			t.Errorf("expected '%s' as response message but got '%s'", "Reading session created successfully", actualResponseBody.Message)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if int(val["current_page"].(float64)) != expectedServiceResponse.CurrentPage {
+				t.Errorf("expected current_page '%d' but got '%v'", expectedServiceResponse.CurrentPage, val["current_page"])
+			}
+
+			if val["status"] != expectedServiceResponse.Status {
+				t.Errorf("expected status '%s' but got '%s'", expectedServiceResponse.Status, val["status"])
+			}
+
+			if val["completed_date"] != expectedServiceResponse.CompletedDate {
+				t.Errorf("expected completed_date '%s' but got '%s'", expectedServiceResponse.CompletedDate, val["completed_date"])
+			}
+
+			session, ok := val["session"].(map[string]interface{})
+			if ok {
+				if session["id"] != expectedServiceResponse.Session.Id {
+					t.Errorf("expected session id '%s' but got '%s'", expectedServiceResponse.Session.Id, session["id"])
+				}
+
+				if int(session["end_page"].(float64)) != expectedServiceResponse.Session.EndPage {
+					t.Errorf("expected end_page '%d' but got '%v'", expectedServiceResponse.Session.EndPage, session["end_page"])
+				}
+			} else {
+				t.Error("session should be true but got false")
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual path values and request body that has been passed to service
+		if !reflect.DeepEqual(mockService.CreateCalledWithPathValue, pathValue) {
+			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.CreateCalledWithPathValue)
+		}
+
+		if !reflect.DeepEqual(mockService.CreateCalledWithRequest, sessionRequest) {
+			t.Errorf("expected %+v as request body but got %+v", sessionRequest, mockService.CreateCalledWithRequest)
+		}
+	})
+
+	t.Run("create reading session with invalid data", func(t *testing.T) {
+		cases := []struct {
+			Name           string
+			SessionRequest web.CreateReadingSessionRequest
+			ErrField       string
+			ErrMessage     string
+		}{
+			{
+				Name: "required session_date",
+				SessionRequest: web.CreateReadingSessionRequest{
+					StartPage: 1,
+					EndPage:   20,
+				},
+				ErrField:   "session_date",
+				ErrMessage: "session_date is required",
+			},
+			{
+				Name: "invalid session_date",
+				SessionRequest: web.CreateReadingSessionRequest{
+					SessionDate: "29-09-2025",
+					StartPage:   1,
+					EndPage:     20,
+				},
+				ErrField:   "session_date",
+				ErrMessage: "use YYYY-MM-DD for valid datetime",
+			},
+			{
+				Name: "required end_page",
+				SessionRequest: web.CreateReadingSessionRequest{
+					SessionDate: "2025-09-29",
+					StartPage:   1,
+				},
+				ErrField:   "end_page",
+				ErrMessage: "end_page is required",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				sessionRequest := c.SessionRequest
+				expectedServiceError := validate.Struct(sessionRequest)
+
+				mockService := &MockReadingSessionService{
+					MockError: expectedServiceError,
+				}
+
+				handler := NewReadingSessionHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/sessions", ToJSON(sessionRequest))
+				res := httptest.NewRecorder()
+
+				// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+				req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+
+	t.Run("create reading session beyond total_page", func(t *testing.T) {
+		sessionRequest := web.CreateReadingSessionRequest{
+			SessionDate: "2025-09-29",
+			StartPage:   350,
+			EndPage:     400,
+		}
+		expectedServiceError := []appError.ErrAggregate{
+			{
+				Field:   "end_page",
+				Message: "end_page must not be greater than total_page (379)",
+			},
+		}
+
+		mockService := &MockReadingSessionService{
+			MockError: appError.NewAppError(
+				http.StatusBadRequest,
+				expectedServiceError,
+				nil,
+			),
+		}
+
+		handler := NewReadingSessionHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/sessions", ToJSON(sessionRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebFailedResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		errorList, ok := actualResponseBody.Errors.([]interface{})
+		if ok {
+			val, ok := errorList[0].(map[string]interface{})
+			if ok {
+				if val["field"] != "end_page" {
+					t.Errorf("expected error field is %s but got %s", "end_page", val["field"])
+				}
+
+				if val["message"] != "end_page must not be greater than total_page (379)" {
+					t.Errorf("expected error message is %s but got %s", "end_page must not be greater than total_page (379)", val["message"])
+				}
+			} else {
+				t.Error("val should be true but got false")
+			}
+		} else {
+			t.Error("errorList should be true but got false")
+		}
+	})
+}
+
+func TestReadingSessionGetAllHandler(t *testing.T) {
+	t.Run("get all reading sessions of a book", func(t *testing.T) {
+		pathValue := web.PathParamsGetReadingSessions{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+		}
+		expectedServiceResponse := []web.ReadingSessionResponse{
+			{
+				Id:          "5b0f7e0a-3f58-4a36-9a3c-7a8d8f0f2d11",
+				BookId:      "43723811-c8e3-4cba-85cc-142954064ae4",
+				SessionDate: "2025-09-29",
+				StartPage:   350,
+				EndPage:     379,
+				MinutesRead: 45,
+			},
+		}
+
+		mockService := &MockReadingSessionService{
+			MockGetAllResponse: expectedServiceResponse,
+		}
+
+		handler := NewReadingSessionHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/sessions", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if ok {
+			val, ok := dataList[0].(map[string]interface{})
+			if ok {
+				if val["id"] != expectedServiceResponse[0].Id {
+					t.Errorf("expected %s as id but got %s", expectedServiceResponse[0].Id, val["id"])
+				}
+
+				if val["session_date"] != expectedServiceResponse[0].SessionDate {
+					t.Errorf("expected %s as session_date but got %s", expectedServiceResponse[0].SessionDate, val["session_date"])
+				}
+
+				if int(val["minutes_read"].(float64)) != expectedServiceResponse[0].MinutesRead {
+					t.Errorf("expected %d as minutes_read but got %v", expectedServiceResponse[0].MinutesRead, val["minutes_read"])
+				}
+			} else {
+				t.Error("val should be true but got false")
+			}
+		} else {
+			t.Error("dataList should be true but got false")
+		}
+
+		// Check actual path values that has been parsed in service
+		if !reflect.DeepEqual(mockService.GetAllCalledWithPathValue, pathValue) {
+			t.Errorf("expected %+v as path value but got %+v", pathValue, mockService.GetAllCalledWithPathValue)
+		}
+	})
+
+	t.Run("get reading sessions of a book that is not found", func(t *testing.T) {
+		expectedServiceError := []appError.ErrAggregate{
+			{
+				Field:   "id",
+				Message: "book with id 'd3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a' is not found",
+			},
+		}
+
+		mockService := &MockReadingSessionService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				expectedServiceError,
+				nil,
+			),
+		}
+
+		handler := NewReadingSessionHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a/sessions", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "d3b07384-d9a1-4f5c-8e2e-3c4e4f5e6f7a")
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
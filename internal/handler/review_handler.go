@@ -0,0 +1,11 @@
+package handler
+
+import "net/http"
+
+type ReviewHandler interface {
+	PutBookReview(w http.ResponseWriter, r *http.Request)
+	GetBookReview(w http.ResponseWriter, r *http.Request)
+	GetBookReviewHistory(w http.ResponseWriter, r *http.Request)
+	DeleteBookReview(w http.ResponseWriter, r *http.Request)
+	GetAuthorRatings(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+func NewReviewHandler(reviewService service.ReviewService) ReviewHandler {
+	return &ReviewHandlerImpl{
+		ReviewService: reviewService,
+	}
+}
+
+type ReviewHandlerImpl struct {
+	ReviewService service.ReviewService
+}
+
+func (handler *ReviewHandlerImpl) PutBookReview(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReview{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to reviewRequest
+	reviewRequest := web.PutReviewRequest{}
+	err := helper.ReadFromRequestBody(r, &reviewRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	reviewResponse, err := handler.ReviewService.PutBookReview(r.Context(), pathValue, reviewRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to save book review")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Review saved successfully",
+		Data:    reviewResponse,
+	})
+}
+
+func (handler *ReviewHandlerImpl) GetBookReview(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReview{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	reviewResponse, err := handler.ReviewService.GetBookReview(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get book review")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get book review",
+		Data:    reviewResponse,
+	})
+}
+
+func (handler *ReviewHandlerImpl) GetBookReviewHistory(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReview{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	revisionsResponse, err := handler.ReviewService.GetBookReviewHistory(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get book review history")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get book review history",
+		Data:    revisionsResponse,
+	})
+}
+
+func (handler *ReviewHandlerImpl) DeleteBookReview(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookReview{
+		BookId: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	err := handler.ReviewService.DeleteBookReview(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete book review")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *ReviewHandlerImpl) GetAuthorRatings(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get author ratings")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get author ratings")
+		return
+	}
+
+	queries := web.QueryParamsGetAuthorRatings{
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	ratingsResponse, meta, err := handler.ReviewService.GetAuthorRatings(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get author ratings")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get author ratings",
+		Data:    ratingsResponse,
+		Meta:    meta,
+	})
+}
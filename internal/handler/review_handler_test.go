@@ -0,0 +1,364 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockReviewService struct {
+	// PutBookReview, GetBookReview, GetBookReviewHistory and DeleteBookReview
+	MockPathValue       web.PathParamsBookReview
+	PutMockRequest      web.PutReviewRequest
+	ReviewMockResponse  web.ReviewResponse
+	HistoryMockResponse []web.ReviewRevisionResponse
+
+	// GetAuthorRatings
+	RatingsMockQuery    web.QueryParamsGetAuthorRatings
+	RatingsMockResponse []web.AuthorRatingResponse
+	RatingsMockMeta     web.PaginationMeta
+
+	MockError error
+}
+
+func (m *MockReviewService) PutBookReview(ctx context.Context, pathValues web.PathParamsBookReview, request web.PutReviewRequest) (web.ReviewResponse, error) {
+	m.MockPathValue = pathValues
+	m.PutMockRequest = request
+
+	if m.MockError != nil {
+		return web.ReviewResponse{}, m.MockError
+	}
+
+	return m.ReviewMockResponse, nil
+}
+
+func (m *MockReviewService) GetBookReview(ctx context.Context, pathValues web.PathParamsBookReview) (web.ReviewResponse, error) {
+	m.MockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.ReviewResponse{}, m.MockError
+	}
+
+	return m.ReviewMockResponse, nil
+}
+
+func (m *MockReviewService) GetBookReviewHistory(ctx context.Context, pathValues web.PathParamsBookReview) ([]web.ReviewRevisionResponse, error) {
+	m.MockPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.HistoryMockResponse, nil
+}
+
+func (m *MockReviewService) DeleteBookReview(ctx context.Context, pathValues web.PathParamsBookReview) error {
+	m.MockPathValue = pathValues
+
+	return m.MockError
+}
+
+func (m *MockReviewService) GetAuthorRatings(ctx context.Context, queries web.QueryParamsGetAuthorRatings) ([]web.AuthorRatingResponse, web.PaginationMeta, error) {
+	m.RatingsMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.RatingsMockResponse, m.RatingsMockMeta, nil
+}
+
+func TestReviewPutBookReviewHandler(t *testing.T) {
+	t.Run("put book review", func(t *testing.T) {
+		reviewRequest := web.PutReviewRequest{
+			Rating: 4.5,
+			Body:   "A **haunting** story about the people who were taken.",
+		}
+		expectedServiceResponse := web.ReviewResponse{
+			Id:     "7d6c5b4a-3f2e-4d1c-8b0a-9f8e7d6c5b4a",
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			Rating: 4.5,
+			Body:   "A **haunting** story about the people who were taken.",
+		}
+
+		mockService := &MockReviewService{
+			ReviewMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewReviewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/review", ToJSON(reviewRequest))
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.PutBookReview(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["rating"] != expectedServiceResponse.Rating {
+				t.Errorf("expected rating %v but got %v", expectedServiceResponse.Rating, val["rating"])
+			}
+
+			if val["body"] != expectedServiceResponse.Body {
+				t.Errorf("expected body '%s' but got '%s'", expectedServiceResponse.Body, val["body"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual request body and path values that has been passed to service
+		if !reflect.DeepEqual(mockService.PutMockRequest, reviewRequest) {
+			t.Errorf("expected %+v as request body but got %+v", reviewRequest, mockService.PutMockRequest)
+		}
+
+		if mockService.MockPathValue.BookId != "43723811-c8e3-4cba-85cc-142954064ae4" {
+			t.Errorf("expected id %s but got %s", "43723811-c8e3-4cba-85cc-142954064ae4", mockService.MockPathValue.BookId)
+		}
+	})
+
+	t.Run("put book review with invalid rating", func(t *testing.T) {
+		cases := []struct {
+			Name          string
+			ReviewRequest web.PutReviewRequest
+			ErrMessage    string
+		}{
+			{
+				Name:          "required",
+				ReviewRequest: web.PutReviewRequest{},
+				ErrMessage:    "rating is required",
+			},
+			{
+				Name: "above five stars",
+				ReviewRequest: web.PutReviewRequest{
+					Rating: 5.5,
+				},
+				ErrMessage: "rating must be between 0.5 and 5 in steps of 0.5",
+			},
+			{
+				Name: "not a half star step",
+				ReviewRequest: web.PutReviewRequest{
+					Rating: 3.2,
+				},
+				ErrMessage: "rating must be between 0.5 and 5 in steps of 0.5",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockReviewService{
+					MockError: validate.Struct(c.ReviewRequest),
+				}
+
+				handler := NewReviewHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPut, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/review", ToJSON(c.ReviewRequest))
+				res := httptest.NewRecorder()
+
+				// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+				req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+				handler.PutBookReview(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != "rating" {
+							t.Errorf("expected error field is %s but got %s", "rating", val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestReviewGetBookReviewHandler(t *testing.T) {
+	t.Run("get review of a book without review", func(t *testing.T) {
+		mockService := &MockReviewService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: "book with id '43723811-c8e3-4cba-85cc-142954064ae4' has no review",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewReviewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/review", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetBookReview(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
+
+func TestReviewGetBookReviewHistoryHandler(t *testing.T) {
+	t.Run("get review history", func(t *testing.T) {
+		expectedServiceResponse := []web.ReviewRevisionResponse{
+			{
+				Id:     "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+				Rating: 4,
+				Body:   "Good, but slow in the middle.",
+			},
+			{
+				Id:     "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+				Rating: 3.5,
+			},
+		}
+
+		mockService := &MockReviewService{
+			HistoryMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewReviewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/review/history", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+
+		handler.GetBookReviewHistory(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != len(expectedServiceResponse) {
+			t.Fatalf("expected %d revisions but got %v", len(expectedServiceResponse), actualResponseBody.Data)
+		}
+	})
+}
+
+func TestReviewGetAuthorRatingsHandler(t *testing.T) {
+	t.Run("get author ratings", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetAuthorRatings{
+			Page: 2,
+			Sort: "-count",
+		}
+		expectedServiceResponse := []web.AuthorRatingResponse{
+			{
+				AuthorId: "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+				FullName: "Leila S. Chudori",
+				Average:  4.25,
+				Count:    2,
+				Distribution: []web.RatingBucketResponse{
+					{Rating: 4, Count: 1},
+					{Rating: 4.5, Count: 1},
+				},
+			},
+		}
+
+		mockService := &MockReviewService{
+			RatingsMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewReviewHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/authors/ratings?page=2&sort=-count", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetAuthorRatings(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != 1 {
+			t.Fatalf("expected 1 author but got %v", actualResponseBody.Data)
+		}
+
+		val, ok := dataList[0].(map[string]interface{})
+		if ok {
+			if val["average"] != expectedServiceResponse[0].Average {
+				t.Errorf("expected average %v but got %v", expectedServiceResponse[0].Average, val["average"])
+			}
+
+			distribution, ok := val["distribution"].([]interface{})
+			if !ok || len(distribution) != 2 {
+				t.Errorf("expected 2 rating buckets but got %v", val["distribution"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.RatingsMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.RatingsMockQuery)
+		}
+	})
+}
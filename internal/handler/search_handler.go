@@ -0,0 +1,7 @@
+package handler
+
+import "net/http"
+
+type SearchHandler interface {
+	Search(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const (
+	querySearch = "q"
+	queryType   = "type"
+)
+
+func NewSearchHandler(searchService service.SearchService) SearchHandler {
+	return &SearchHandlerImpl{
+		SearchService: searchService,
+	}
+}
+
+type SearchHandlerImpl struct {
+	SearchService service.SearchService
+}
+
+func (handler *SearchHandlerImpl) Search(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsSearch{
+		Query:    r.URL.Query().Get(querySearch),
+		Type:     r.URL.Query().Get(queryType),
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Call the service
+	searchResponse, meta, err := handler.SearchService.Search(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to search")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success search",
+		Data:    searchResponse,
+		Meta:    meta,
+	})
+}
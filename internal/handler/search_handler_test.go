@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockSearchService struct {
+	// Search
+	SearchCalledWithQueries web.QueryParamsSearch
+	MockSearchResponse      []web.SearchResultResponse
+	MockSearchMeta          web.PaginationMeta
+
+	MockError error
+}
+
+func (m *MockSearchService) Search(ctx context.Context, queries web.QueryParamsSearch) ([]web.SearchResultResponse, web.PaginationMeta, error) {
+	m.SearchCalledWithQueries = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.MockSearchResponse, m.MockSearchMeta, nil
+}
+
+func TestSearchHandler(t *testing.T) {
+	t.Run("search books and authors", func(t *testing.T) {
+		expectedQueries := web.QueryParamsSearch{
+			Query:    "hobit",
+			Type:     "books",
+			Page:     1,
+			PageSize: 10,
+		}
+		expectedServiceResponse := []web.SearchResultResponse{
+			{
+				Type:    "book",
+				Id:      "43723811-c8e3-4cba-85cc-142954064ae4",
+				Title:   "The Hobbit",
+				Snippet: "The Hobbit",
+				Rank:    0.54,
+			},
+		}
+
+		mockService := &MockSearchService{
+			MockSearchResponse: expectedServiceResponse,
+			MockSearchMeta: web.PaginationMeta{
+				Page:       1,
+				PageSize:   10,
+				Total:      1,
+				TotalPages: 1,
+			},
+		}
+
+		handler := NewSearchHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=hobit&type=books&page=1&page_size=10", nil)
+		res := httptest.NewRecorder()
+
+		handler.Search(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if ok {
+			val, ok := dataList[0].(map[string]interface{})
+			if ok {
+				if val["type"] != expectedServiceResponse[0].Type {
+					t.Errorf("expected %s as type but got %s", expectedServiceResponse[0].Type, val["type"])
+				}
+
+				if val["title"] != expectedServiceResponse[0].Title {
+					t.Errorf("expected %s as title but got %s", expectedServiceResponse[0].Title, val["title"])
+				}
+			} else {
+				t.Error("val should be true but got false")
+			}
+		} else {
+			t.Error("dataList should be true but got false")
+		}
+
+		// Check response meta
+		meta, ok := actualResponseBody.Meta.(map[string]interface{})
+		if ok {
+			if int(meta["total"].(float64)) != 1 {
+				t.Errorf("expected total 1 but got %v", meta["total"])
+			}
+		} else {
+			t.Error("meta should be true but got false")
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.SearchCalledWithQueries, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.SearchCalledWithQueries)
+		}
+	})
+
+	t.Run("search with invalid queries", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			Queries    web.QueryParamsSearch
+			URL        string
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "required q",
+				Queries:    web.QueryParamsSearch{},
+				URL:        "/api/v1/search",
+				ErrField:   "q",
+				ErrMessage: "q is required",
+			},
+			{
+				Name: "invalid type",
+				Queries: web.QueryParamsSearch{
+					Query: "dune",
+					Type:  "tags",
+				},
+				URL:        "/api/v1/search?q=dune&type=tags",
+				ErrField:   "type",
+				ErrMessage: "type must be one of 'all', 'books', 'authors'",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockSearchService{
+					MockError: validate.Struct(c.Queries),
+				}
+
+				handler := NewSearchHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodGet, c.URL, nil)
+				res := httptest.NewRecorder()
+
+				handler.Search(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
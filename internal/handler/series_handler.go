@@ -0,0 +1,13 @@
+package handler
+
+import "net/http"
+
+type SeriesHandler interface {
+	Create(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
+	GetById(w http.ResponseWriter, r *http.Request)
+	UpdateById(w http.ResponseWriter, r *http.Request)
+	DeleteById(w http.ResponseWriter, r *http.Request)
+	GetBooks(w http.ResponseWriter, r *http.Request)
+	GetNextUnread(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const queryAuthorId = "author_id"
+
+func NewSeriesHandler(seriesService service.SeriesService) SeriesHandler {
+	return &SeriesHandlerImpl{
+		SeriesService: seriesService,
+	}
+}
+
+type SeriesHandlerImpl struct {
+	SeriesService service.SeriesService
+}
+
+func (handler *SeriesHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to seriesRequest
+	seriesRequest := web.CreateSeriesRequest{}
+	err := helper.ReadFromRequestBody(r, &seriesRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	seriesResponse, err := handler.SeriesService.CreateNewSeries(r.Context(), seriesRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new series")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Series created successfully",
+		Data:    seriesResponse,
+	})
+}
+
+func (handler *SeriesHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get series")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get series")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsGetSeries{
+		Name:     r.URL.Query().Get(queryName),
+		AuthorId: r.URL.Query().Get(queryAuthorId),
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	seriesResponse, meta, err := handler.SeriesService.GetAllSeries(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get series")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all series",
+		Data:    seriesResponse,
+		Meta:    meta,
+	})
+}
+
+func (handler *SeriesHandlerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGetSeries{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	seriesResponse, err := handler.SeriesService.GetSeriesById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get series by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get series",
+		Data:    seriesResponse,
+	})
+}
+
+func (handler *SeriesHandlerImpl) UpdateById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsUpdateSeries{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to seriesRequest
+	seriesRequest := web.UpdateSeriesRequest{}
+	err := helper.ReadFromRequestBody(r, &seriesRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	seriesResponse, err := handler.SeriesService.UpdateSeriesById(r.Context(), pathValue, seriesRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to update series by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Series updated successfully",
+		Data:    seriesResponse,
+	})
+}
+
+func (handler *SeriesHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsDeleteSeries{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	err := handler.SeriesService.DeleteSeriesById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete series by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *SeriesHandlerImpl) GetBooks(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGetSeries{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	volumesResponse, err := handler.SeriesService.GetSeriesBooks(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get series books")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get series books",
+		Data:    volumesResponse,
+	})
+}
+
+func (handler *SeriesHandlerImpl) GetNextUnread(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGetSeries{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	volumeResponse, err := handler.SeriesService.GetNextUnreadInSeries(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get next unread book in series")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get next unread book in series",
+		Data:    volumeResponse,
+	})
+}
@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockSeriesService struct {
+	// CreateNewSeries
+	CreateMockRequest  web.CreateSeriesRequest
+	CreateMockResponse web.CreateSeriesResponse
+
+	// GetAllSeries
+	GetAllMockQuery    web.QueryParamsGetSeries
+	GetAllMockResponse []web.GetSeriesResponse
+	GetAllMockMeta     web.PaginationMeta
+
+	// GetSeriesById, GetSeriesBooks and GetNextUnreadInSeries
+	GetByIdMockPathValue web.PathParamsGetSeries
+	GetByIdMockResponse  web.GetSeriesResponse
+	BooksMockResponse    []web.SeriesVolumeResponse
+	NextMockResponse     web.SeriesVolumeResponse
+
+	// UpdateSeriesById
+	UpdateByIdMockPathValue web.PathParamsUpdateSeries
+	UpdateByIdMockRequest   web.UpdateSeriesRequest
+	UpdateByIdMockResponse  web.UpdateSeriesResponse
+
+	// DeleteSeriesById
+	DeleteByIdMockPathValue web.PathParamsDeleteSeries
+
+	MockError error
+}
+
+func (m *MockSeriesService) CreateNewSeries(ctx context.Context, request web.CreateSeriesRequest) (web.CreateSeriesResponse, error) {
+	m.CreateMockRequest = request
+
+	if m.MockError != nil {
+		return web.CreateSeriesResponse{}, m.MockError
+	}
+
+	return m.CreateMockResponse, nil
+}
+
+func (m *MockSeriesService) GetAllSeries(ctx context.Context, queries web.QueryParamsGetSeries) ([]web.GetSeriesResponse, web.PaginationMeta, error) {
+	m.GetAllMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.GetAllMockResponse, m.GetAllMockMeta, nil
+}
+
+func (m *MockSeriesService) GetSeriesById(ctx context.Context, pathValues web.PathParamsGetSeries) (web.GetSeriesResponse, error) {
+	m.GetByIdMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.GetSeriesResponse{}, m.MockError
+	}
+
+	return m.GetByIdMockResponse, nil
+}
+
+func (m *MockSeriesService) UpdateSeriesById(ctx context.Context, pathValues web.PathParamsUpdateSeries, request web.UpdateSeriesRequest) (web.UpdateSeriesResponse, error) {
+	m.UpdateByIdMockPathValue = pathValues
+	m.UpdateByIdMockRequest = request
+
+	if m.MockError != nil {
+		return web.UpdateSeriesResponse{}, m.MockError
+	}
+
+	return m.UpdateByIdMockResponse, nil
+}
+
+func (m *MockSeriesService) DeleteSeriesById(ctx context.Context, pathValues web.PathParamsDeleteSeries) error {
+	m.DeleteByIdMockPathValue = pathValues
+
+	return m.MockError
+}
+
+func (m *MockSeriesService) GetSeriesBooks(ctx context.Context, pathValues web.PathParamsGetSeries) ([]web.SeriesVolumeResponse, error) {
+	m.GetByIdMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.BooksMockResponse, nil
+}
+
+func (m *MockSeriesService) GetNextUnreadInSeries(ctx context.Context, pathValues web.PathParamsGetSeries) (web.SeriesVolumeResponse, error) {
+	m.GetByIdMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.SeriesVolumeResponse{}, m.MockError
+	}
+
+	return m.NextMockResponse, nil
+}
+
+func TestSeriesCreateHandler(t *testing.T) {
+	t.Run("create series", func(t *testing.T) {
+		seriesRequest := web.CreateSeriesRequest{
+			Name:     "The Expanse",
+			AuthorId: "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+		}
+		expectedServiceResponse := web.CreateSeriesResponse{
+			Id:       "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f",
+			Name:     "The Expanse",
+			AuthorId: "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+		}
+
+		mockService := &MockSeriesService{
+			CreateMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewSeriesHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/series", ToJSON(seriesRequest))
+		res := httptest.NewRecorder()
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["name"] != expectedServiceResponse.Name {
+				t.Errorf("expected name '%s' but got '%s'", expectedServiceResponse.Name, val["name"])
+			}
+
+			if val["author_id"] != expectedServiceResponse.AuthorId {
+				t.Errorf("expected author_id '%s' but got '%s'", expectedServiceResponse.AuthorId, val["author_id"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual request body that has been passed to service
+		if !reflect.DeepEqual(mockService.CreateMockRequest, seriesRequest) {
+			t.Errorf("expected %+v as request body but got %+v", seriesRequest, mockService.CreateMockRequest)
+		}
+	})
+
+	t.Run("create series with invalid request", func(t *testing.T) {
+		cases := []struct {
+			Name          string
+			SeriesRequest web.CreateSeriesRequest
+			ErrField      string
+			ErrMessage    string
+		}{
+			{
+				Name:          "required",
+				SeriesRequest: web.CreateSeriesRequest{},
+				ErrField:      "name",
+				ErrMessage:    "name is required",
+			},
+			{
+				Name: "uuid",
+				SeriesRequest: web.CreateSeriesRequest{
+					Name:     "The Expanse",
+					AuthorId: "InvalidUUID",
+				},
+				ErrField:   "author_id",
+				ErrMessage: "'InvalidUUID' is not a valid UUID",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockSeriesService{
+					MockError: validate.Struct(c.SeriesRequest),
+				}
+
+				handler := NewSeriesHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/series", ToJSON(c.SeriesRequest))
+				res := httptest.NewRecorder()
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestSeriesGetBooksHandler(t *testing.T) {
+	t.Run("get series books in order", func(t *testing.T) {
+		expectedServiceResponse := []web.SeriesVolumeResponse{
+			{
+				Id:             "43723811-c8e3-4cba-85cc-142954064ae4",
+				Name:           "Leviathan Wakes",
+				SeriesPosition: 1,
+				Status:         "completed",
+				CurrentPage:    561,
+				TotalPage:      561,
+				CompletedDate:  "2025-02-10",
+			},
+			{
+				Id:             "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+				Name:           "The Churn",
+				SeriesPosition: 1.5,
+				Status:         "reading",
+				CurrentPage:    40,
+				TotalPage:      80,
+			},
+		}
+
+		mockService := &MockSeriesService{
+			BooksMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewSeriesHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/series/0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f/books", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f")
+
+		handler.GetBooks(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != len(expectedServiceResponse) {
+			t.Fatalf("expected %d volumes but got %v", len(expectedServiceResponse), actualResponseBody.Data)
+		}
+
+		for i, data := range dataList {
+			val, ok := data.(map[string]interface{})
+			if !ok {
+				t.Fatal("val should be true but got false")
+			}
+
+			if val["series_position"] != expectedServiceResponse[i].SeriesPosition {
+				t.Errorf("expected series_position %v but got %v", expectedServiceResponse[i].SeriesPosition, val["series_position"])
+			}
+
+			if val["status"] != expectedServiceResponse[i].Status {
+				t.Errorf("expected status '%s' but got '%s'", expectedServiceResponse[i].Status, val["status"])
+			}
+		}
+
+		// Check actual path values that has been parsed in service
+		if mockService.GetByIdMockPathValue.Id != "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f" {
+			t.Errorf("expected id %s but got %s", "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f", mockService.GetByIdMockPathValue.Id)
+		}
+	})
+}
+
+func TestSeriesGetNextUnreadHandler(t *testing.T) {
+	t.Run("get next unread book in series", func(t *testing.T) {
+		expectedServiceResponse := web.SeriesVolumeResponse{
+			Id:             "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+			Name:           "The Churn",
+			SeriesPosition: 1.5,
+			Status:         "reading",
+			CurrentPage:    40,
+			TotalPage:      80,
+		}
+
+		mockService := &MockSeriesService{
+			NextMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewSeriesHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/series/0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f/next", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f")
+
+		handler.GetNextUnread(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["id"] != expectedServiceResponse.Id {
+				t.Errorf("expected id '%s' but got '%s'", expectedServiceResponse.Id, val["id"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+	})
+
+	t.Run("get next unread book in a finished series", func(t *testing.T) {
+		mockService := &MockSeriesService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: "there is no unread book in series with id '0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f'",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewSeriesHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/series/0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f/next", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "0b5d3c1e-8f2a-4c6b-9d7e-1a2b3c4d5e6f")
+
+		handler.GetNextUnread(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package handler
+
+import "net/http"
+
+type StatsHandler interface {
+	GetStats(w http.ResponseWriter, r *http.Request)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const (
+	queryFrom    = "from"
+	queryTo      = "to"
+	queryGroupBy = "group_by"
+)
+
+func NewStatsHandler(statsService service.StatsService) StatsHandler {
+	return &StatsHandlerImpl{
+		StatsService: statsService,
+	}
+}
+
+type StatsHandlerImpl struct {
+	StatsService service.StatsService
+}
+
+func (handler *StatsHandlerImpl) GetStats(w http.ResponseWriter, r *http.Request) {
+	// Get query params if any
+	queries := web.QueryParamsGetStats{
+		From:    r.URL.Query().Get(queryFrom),
+		To:      r.URL.Query().Get(queryTo),
+		GroupBy: r.URL.Query().Get(queryGroupBy),
+	}
+
+	// Call the service
+	statsResponse, err := handler.StatsService.GetStats(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get stats")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get stats",
+		Data:    statsResponse,
+	})
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockStatsService struct {
+	// GetStats
+	GetMockQuery      web.QueryParamsGetStats
+	StatsMockResponse web.StatsResponse
+
+	MockError error
+}
+
+func (m *MockStatsService) GetStats(ctx context.Context, queries web.QueryParamsGetStats) (web.StatsResponse, error) {
+	m.GetMockQuery = queries
+
+	if m.MockError != nil {
+		return web.StatsResponse{}, m.MockError
+	}
+
+	return m.StatsMockResponse, nil
+}
+
+func TestStatsGetHandler(t *testing.T) {
+	t.Run("get stats grouped by week", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetStats{
+			From:    "2026-01-01",
+			To:      "2026-03-31",
+			GroupBy: "week",
+		}
+		expectedServiceResponse := web.StatsResponse{
+			From:    "2026-01-01",
+			To:      "2026-03-31",
+			GroupBy: "week",
+			Totals: web.StatsTotalsResponse{
+				BooksCompleted:    3,
+				Rereads:           1,
+				PagesRead:         1042,
+				AverageBookLength: 347.3,
+			},
+			Timeline: []web.StatsBucketResponse{
+				{PeriodStart: "2025-12-29", BooksCompleted: 1, PagesRead: 310},
+			},
+			Status: []web.StatusCountResponse{
+				{Status: "completed", Count: 12},
+			},
+			TopAuthors: []web.AuthorStatsResponse{
+				{AuthorId: "5c1f2a3b-7d4e-4f6a-8b9c-0d1e2f3a4b5c", FullName: "Ursula K. Le Guin", BooksCompleted: 2, Pages: 680},
+			},
+			Nationalities: []web.NationalityStatsResponse{
+				{Nationality: "American", BooksCompleted: 3, Authors: 2},
+			},
+		}
+
+		mockService := &MockStatsService{
+			StatsMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewStatsHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/stats?from=2026-01-01&to=2026-03-31&group_by=week", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetStats(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody struct {
+			Data web.StatsResponse `json:"data"`
+		}
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		if !reflect.DeepEqual(actualResponseBody.Data, expectedServiceResponse) {
+			t.Errorf("expected %+v as data but got %+v", expectedServiceResponse, actualResponseBody.Data)
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.GetMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.GetMockQuery)
+		}
+	})
+
+	t.Run("get stats with invalid queries", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			Queries    web.QueryParamsGetStats
+			ErrField   string
+			ErrMessage string
+		}{
+			{
+				Name:       "invalid from",
+				Queries:    web.QueryParamsGetStats{From: "01-01-2026"},
+				ErrField:   "from",
+				ErrMessage: "use YYYY-MM-DD for valid datetime",
+			},
+			{
+				Name:       "unknown grouping",
+				Queries:    web.QueryParamsGetStats{GroupBy: "decade"},
+				ErrField:   "group_by",
+				ErrMessage: "group_by must be one of 'day', 'week', 'month', 'year'",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockStatsService{
+					MockError: validate.Struct(c.Queries),
+				}
+
+				handler := NewStatsHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/stats", nil)
+				res := httptest.NewRecorder()
+
+				handler.GetStats(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != c.ErrField {
+							t.Errorf("expected error field is %s but got %s", c.ErrField, val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
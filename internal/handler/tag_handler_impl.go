@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+const wildcardTagId = "tag_id"
+
+func NewTagHandler(tagService service.TagService) TagHandler {
+	return &TagHandlerImpl{
+		TagService: tagService,
+	}
+}
+
+type TagHandlerImpl struct {
+	TagService service.TagService
+}
+
+func (handler *TagHandlerImpl) Create(w http.ResponseWriter, r *http.Request) {
+	// Get request body and write it to tagRequest
+	tagRequest := web.CreateTagRequest{}
+	err := helper.ReadFromRequestBody(r, &tagRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	tagResponse, err := handler.TagService.CreateNewTag(r.Context(), tagRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to create new tag")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusCreated,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusCreated, web.WebSuccessResponse{
+		Message: "Tag created successfully",
+		Data:    tagResponse,
+	})
+}
+
+func (handler *TagHandlerImpl) GetAll(w http.ResponseWriter, r *http.Request) {
+	// Get pagination query params if any
+	page, err := readIntQuery(r, queryPage)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get tags")
+		return
+	}
+
+	pageSize, err := readIntQuery(r, queryPageSize)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get tags")
+		return
+	}
+
+	// Get query params if any
+	queries := web.QueryParamsGetTags{
+		Name:     r.URL.Query().Get(queryName),
+		Page:     page,
+		PageSize: pageSize,
+		Sort:     r.URL.Query().Get(querySort),
+	}
+
+	// Call the service
+	tagsResponse, meta, err := handler.TagService.GetAllTags(r.Context(), queries)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get tags")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get all tags",
+		Data:    tagsResponse,
+		Meta:    meta,
+	})
+}
+
+func (handler *TagHandlerImpl) GetById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsGetTag{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	tagResponse, err := handler.TagService.GetTagById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get tag by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get tag",
+		Data:    tagResponse,
+	})
+}
+
+func (handler *TagHandlerImpl) UpdateById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsUpdateTag{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Get request body and write it to tagRequest
+	tagRequest := web.UpdateTagRequest{}
+	err := helper.ReadFromRequestBody(r, &tagRequest)
+	if err != nil {
+		appError.RequestJSONErrorHandler(w, err)
+		return
+	}
+
+	// Call the service
+	tagResponse, err := handler.TagService.UpdateTagById(r.Context(), pathValue, tagRequest)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to update tag by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Tag updated successfully",
+		Data:    tagResponse,
+	})
+}
+
+func (handler *TagHandlerImpl) DeleteById(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsDeleteTag{
+		Id: r.PathValue(wildcardId),
+	}
+
+	// Call the service
+	err := handler.TagService.DeleteTagById(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to delete tag by id")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler *TagHandlerImpl) AttachToBook(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookTag{
+		BookId: r.PathValue(wildcardId),
+		TagId:  r.PathValue(wildcardTagId),
+	}
+
+	// Call the service
+	tagsResponse, err := handler.TagService.AttachTagToBook(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to attach tag to book")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Tag attached to book successfully",
+		Data:    tagsResponse,
+	})
+}
+
+func (handler *TagHandlerImpl) DetachFromBook(w http.ResponseWriter, r *http.Request) {
+	// Get path values if any
+	pathValue := web.PathParamsBookTag{
+		BookId: r.PathValue(wildcardId),
+		TagId:  r.PathValue(wildcardTagId),
+	}
+
+	// Call the service
+	err := handler.TagService.DetachTagFromBook(r.Context(), pathValue)
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to detach tag from book")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusNoContent,
+	)
+
+	// Set to 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,435 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type MockTagService struct {
+	// CreateNewTag
+	CreateMockRequest  web.CreateTagRequest
+	CreateMockResponse web.CreateTagResponse
+
+	// GetAllTags
+	GetAllMockQuery    web.QueryParamsGetTags
+	GetAllMockResponse []web.GetTagResponse
+	GetAllMockMeta     web.PaginationMeta
+
+	// GetTagById
+	GetByIdMockPathValue web.PathParamsGetTag
+	GetByIdMockResponse  web.GetTagResponse
+
+	// UpdateTagById
+	UpdateByIdMockPathValue web.PathParamsUpdateTag
+	UpdateByIdMockRequest   web.UpdateTagRequest
+	UpdateByIdMockResponse  web.UpdateTagResponse
+
+	// DeleteTagById
+	DeleteByIdMockPathValue web.PathParamsDeleteTag
+
+	// AttachTagToBook and DetachTagFromBook
+	BookTagMockPathValue web.PathParamsBookTag
+	AttachMockResponse   []web.BookTagResponse
+
+	MockError error
+}
+
+func (m *MockTagService) CreateNewTag(ctx context.Context, request web.CreateTagRequest) (web.CreateTagResponse, error) {
+	m.CreateMockRequest = request
+
+	if m.MockError != nil {
+		return web.CreateTagResponse{}, m.MockError
+	}
+
+	return m.CreateMockResponse, nil
+}
+
+func (m *MockTagService) GetAllTags(ctx context.Context, queries web.QueryParamsGetTags) ([]web.GetTagResponse, web.PaginationMeta, error) {
+	m.GetAllMockQuery = queries
+
+	if m.MockError != nil {
+		return nil, web.PaginationMeta{}, m.MockError
+	}
+
+	return m.GetAllMockResponse, m.GetAllMockMeta, nil
+}
+
+func (m *MockTagService) GetTagById(ctx context.Context, pathValues web.PathParamsGetTag) (web.GetTagResponse, error) {
+	m.GetByIdMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return web.GetTagResponse{}, m.MockError
+	}
+
+	return m.GetByIdMockResponse, nil
+}
+
+func (m *MockTagService) UpdateTagById(ctx context.Context, pathValues web.PathParamsUpdateTag, request web.UpdateTagRequest) (web.UpdateTagResponse, error) {
+	m.UpdateByIdMockPathValue = pathValues
+	m.UpdateByIdMockRequest = request
+
+	if m.MockError != nil {
+		return web.UpdateTagResponse{}, m.MockError
+	}
+
+	return m.UpdateByIdMockResponse, nil
+}
+
+func (m *MockTagService) DeleteTagById(ctx context.Context, pathValues web.PathParamsDeleteTag) error {
+	m.DeleteByIdMockPathValue = pathValues
+
+	return m.MockError
+}
+
+func (m *MockTagService) AttachTagToBook(ctx context.Context, pathValues web.PathParamsBookTag) ([]web.BookTagResponse, error) {
+	m.BookTagMockPathValue = pathValues
+
+	if m.MockError != nil {
+		return nil, m.MockError
+	}
+
+	return m.AttachMockResponse, nil
+}
+
+func (m *MockTagService) DetachTagFromBook(ctx context.Context, pathValues web.PathParamsBookTag) error {
+	m.BookTagMockPathValue = pathValues
+
+	return m.MockError
+}
+
+func TestTagCreateHandler(t *testing.T) {
+	t.Run("create tag", func(t *testing.T) {
+		tagRequest := web.CreateTagRequest{
+			Name: "Fantasy",
+		}
+		expectedServiceResponse := web.CreateTagResponse{
+			Id:   "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b",
+			Name: "Fantasy",
+		}
+
+		mockService := &MockTagService{
+			CreateMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/tags", ToJSON(tagRequest))
+		res := httptest.NewRecorder()
+
+		handler.Create(res, req)
+
+		// Check status code
+		if res.Code != http.StatusCreated {
+			t.Errorf("expected status code of %d but got %d", http.StatusCreated, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if ok {
+			if val["name"] != expectedServiceResponse.Name {
+				t.Errorf("expected name '%s' but got '%s'", expectedServiceResponse.Name, val["name"])
+			}
+		} else {
+			t.Error("val should be true but got false")
+		}
+
+		// Check actual request body that has been passed to service
+		if !reflect.DeepEqual(mockService.CreateMockRequest, tagRequest) {
+			t.Errorf("expected %+v as request body but got %+v", tagRequest, mockService.CreateMockRequest)
+		}
+	})
+
+	t.Run("create tag with invalid name", func(t *testing.T) {
+		cases := []struct {
+			Name       string
+			TagRequest web.CreateTagRequest
+			ErrMessage string
+		}{
+			{
+				Name:       "required",
+				TagRequest: web.CreateTagRequest{},
+				ErrMessage: "name is required",
+			},
+			{
+				Name: "max",
+				TagRequest: web.CreateTagRequest{
+					Name: "A tag name that is far too long to be useful as a label",
+				},
+				ErrMessage: "name must be at most 50 characters",
+			},
+		}
+
+		validate := config.ValidatorInit()
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				mockService := &MockTagService{
+					MockError: validate.Struct(c.TagRequest),
+				}
+
+				handler := NewTagHandler(mockService)
+
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/tags", ToJSON(c.TagRequest))
+				res := httptest.NewRecorder()
+
+				handler.Create(res, req)
+
+				// Check status code
+				if res.Code != http.StatusBadRequest {
+					t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				errorList, ok := actualResponseBody.Errors.([]interface{})
+				if ok {
+					val, ok := errorList[0].(map[string]interface{})
+					if ok {
+						if val["field"] != "name" {
+							t.Errorf("expected error field is %s but got %s", "name", val["field"])
+						}
+
+						if val["message"] != c.ErrMessage {
+							t.Errorf("expected error message is %s but got %s", c.ErrMessage, val["message"])
+						}
+					} else {
+						t.Error("val should be true but got false")
+					}
+				} else {
+					t.Error("errorList should be true but got false")
+				}
+			})
+		}
+	})
+}
+
+func TestTagGetAllHandler(t *testing.T) {
+	t.Run("get all tags with usage counts", func(t *testing.T) {
+		expectedQueries := web.QueryParamsGetTags{
+			Sort: "-book_count",
+		}
+		expectedServiceResponse := []web.GetTagResponse{
+			{
+				Id:        "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b",
+				Name:      "Fantasy",
+				BookCount: 12,
+			},
+			{
+				Id:        "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+				Name:      "Poetry",
+				BookCount: 0,
+			},
+		}
+
+		mockService := &MockTagService{
+			GetAllMockResponse: expectedServiceResponse,
+			GetAllMockMeta: web.PaginationMeta{
+				Page:       1,
+				PageSize:   20,
+				Total:      2,
+				TotalPages: 1,
+			},
+		}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tags?sort=-book_count", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetAll(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if ok {
+			for i, data := range dataList {
+				val, ok := data.(map[string]interface{})
+				if !ok {
+					t.Fatal("val should be true but got false")
+				}
+
+				if val["name"] != expectedServiceResponse[i].Name {
+					t.Errorf("expected name '%s' but got '%s'", expectedServiceResponse[i].Name, val["name"])
+				}
+
+				if int(val["book_count"].(float64)) != expectedServiceResponse[i].BookCount {
+					t.Errorf("expected book_count %d but got %v", expectedServiceResponse[i].BookCount, val["book_count"])
+				}
+			}
+		} else {
+			t.Error("dataList should be true but got false")
+		}
+
+		// Check actual queries that has been passed to service
+		if !reflect.DeepEqual(mockService.GetAllMockQuery, expectedQueries) {
+			t.Errorf("expected %+v as queries but got %+v", expectedQueries, mockService.GetAllMockQuery)
+		}
+	})
+}
+
+func TestTagDeleteByIdHandler(t *testing.T) {
+	t.Run("delete tag by id", func(t *testing.T) {
+		mockService := &MockTagService{}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/tags/5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b")
+
+		handler.DeleteById(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNoContent {
+			t.Errorf("expected status code of %d but got %d", http.StatusNoContent, res.Code)
+		}
+
+		// Check actual path values that has been parsed in service
+		if mockService.DeleteByIdMockPathValue.Id != "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b" {
+			t.Errorf("expected id %s but got %s", "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b", mockService.DeleteByIdMockPathValue.Id)
+		}
+	})
+}
+
+func TestTagAttachToBookHandler(t *testing.T) {
+	t.Run("attach tag to book", func(t *testing.T) {
+		expectedPathValue := web.PathParamsBookTag{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			TagId:  "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b",
+		}
+
+		mockService := &MockTagService{
+			AttachMockResponse: []web.BookTagResponse{
+				{
+					Id:   "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b",
+					Name: "Fantasy",
+				},
+			},
+		}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/tags/5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", expectedPathValue.BookId)
+		req.SetPathValue("tag_id", expectedPathValue.TagId)
+
+		handler.AttachToBook(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		dataList, ok := actualResponseBody.Data.([]interface{})
+		if !ok || len(dataList) != 1 {
+			t.Fatalf("expected 1 tag but got %v", actualResponseBody.Data)
+		}
+
+		// Check actual path values that has been parsed in service
+		if !reflect.DeepEqual(mockService.BookTagMockPathValue, expectedPathValue) {
+			t.Errorf("expected %+v as path value but got %+v", expectedPathValue, mockService.BookTagMockPathValue)
+		}
+	})
+
+	t.Run("attach tag with invalid tag_id", func(t *testing.T) {
+		pathValue := web.PathParamsBookTag{
+			BookId: "43723811-c8e3-4cba-85cc-142954064ae4",
+			TagId:  "InvalidUUID",
+		}
+
+		mockService := &MockTagService{
+			MockError: config.ValidatorInit().Struct(pathValue),
+		}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/tags/InvalidUUID", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", pathValue.BookId)
+		req.SetPathValue("tag_id", pathValue.TagId)
+
+		handler.AttachToBook(res, req)
+
+		// Check status code
+		if res.Code != http.StatusBadRequest {
+			t.Errorf("expected status code of %d but got %d", http.StatusBadRequest, res.Code)
+		}
+	})
+}
+
+func TestTagDetachFromBookHandler(t *testing.T) {
+	t.Run("detach tag that is not attached", func(t *testing.T) {
+		mockService := &MockTagService{
+			MockError: appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "tag_id",
+						Message: "tag with id '5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b' is not attached to book with id '43723811-c8e3-4cba-85cc-142954064ae4'",
+					},
+				},
+				nil,
+			),
+		}
+
+		handler := NewTagHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/books/43723811-c8e3-4cba-85cc-142954064ae4/tags/5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b", nil)
+		res := httptest.NewRecorder()
+
+		// Path value must be set since httptest.NewRequest never goes through http.ServeMux
+		req.SetPathValue("id", "43723811-c8e3-4cba-85cc-142954064ae4")
+		req.SetPathValue("tag_id", "5e1f0c3a-7b2d-4e8f-9a6b-1c2d3e4f5a6b")
+
+		handler.DetachFromBook(res, req)
+
+		// Check status code
+		if res.Code != http.StatusNotFound {
+			t.Errorf("expected status code of %d but got %d", http.StatusNotFound, res.Code)
+		}
+	})
+}
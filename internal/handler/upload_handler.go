@@ -4,4 +4,5 @@ import "net/http"
 
 type UploadHandler interface {
 	GetBookPresignedURL(w http.ResponseWriter, r *http.Request)
+	GetAuthorPresignedURL(w http.ResponseWriter, r *http.Request)
 }
@@ -44,3 +44,28 @@ func (handler *UploadHandlerImpl) GetBookPresignedURL(w http.ResponseWriter, r *
 		},
 	})
 }
+
+func (handler *UploadHandlerImpl) GetAuthorPresignedURL(w http.ResponseWriter, r *http.Request) {
+	// Call the service
+	presignedURLResponse, err := handler.UploadService.GetAuthorPresignedURL(r.Context())
+	if err != nil {
+		appError.ResponseServiceErrorHandler(w, err, "failed to get presigned url")
+		return
+	}
+
+	// Log the info
+	slog.Info("request handled",
+		"method", r.Method,
+		"endpoint", r.URL,
+		"status", http.StatusOK,
+	)
+
+	// Write and send the response
+	helper.WriteToResponseBody(w, http.StatusOK, web.WebSuccessResponse{
+		Message: "Success get presigned URL",
+		Data: web.GetAuthorPresignedURLResponse{
+			URL:      presignedURLResponse.URL,
+			FormData: presignedURLResponse.FormData,
+		},
+	})
+}
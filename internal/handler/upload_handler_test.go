@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,6 +15,9 @@ type MockUploadService struct {
 	// GetBookPresignedURL
 	GetPresignedURLMockResponse web.GetBookPresignedURLResponse
 
+	// GetAuthorPresignedURL
+	GetAuthorPresignedURLMockResponse web.GetAuthorPresignedURLResponse
+
 	MockError error
 }
 
@@ -25,6 +29,14 @@ func (m *MockUploadService) GetBookPresignedURL(ctx context.Context) (web.GetBoo
 	return m.GetPresignedURLMockResponse, nil
 }
 
+func (m *MockUploadService) GetAuthorPresignedURL(ctx context.Context) (web.GetAuthorPresignedURLResponse, error) {
+	if m.MockError != nil {
+		return web.GetAuthorPresignedURLResponse{}, m.MockError
+	}
+
+	return m.GetAuthorPresignedURLMockResponse, nil
+}
+
 func TestGetBookPresignedURL(t *testing.T) {
 	t.Run("get book presigned url", func(t *testing.T) {
 		expectedServiceResponse := web.GetBookPresignedURLResponse{
@@ -113,3 +125,72 @@ func TestGetBookPresignedURL(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAuthorPresignedURL(t *testing.T) {
+	t.Run("get author presigned url", func(t *testing.T) {
+		expectedServiceResponse := web.GetAuthorPresignedURLResponse{
+			URL:      "http://127.0.0.1:9000/author-images/",
+			FormData: map[string]string{"Content-Type": "image/jpeg", "bucket": "author-images", "key": "9b2c7e41-5d0a-4f3e-8c61-2a7d4e9f0b13.jpg"},
+		}
+
+		mockService := &MockUploadService{
+			GetAuthorPresignedURLMockResponse: expectedServiceResponse,
+		}
+
+		handler := NewUploadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/upload/authors/presigned-url", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetAuthorPresignedURL(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Get the actual response
+		var actualResponseBody web.WebSuccessResponse
+		err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+		if err != nil {
+			t.Fatalf("error when parsing res body: %v", err)
+		}
+
+		// Check response body data
+		val, ok := actualResponseBody.Data.(map[string]interface{})
+		if !ok {
+			t.Fatal("val should be true but got false")
+		}
+
+		if val["url"] != expectedServiceResponse.URL {
+			t.Errorf("expected url '%s' but got '%s'", expectedServiceResponse.URL, val["url"])
+		}
+
+		formData, ok := val["form_data"].(map[string]interface{})
+		if !ok {
+			t.Fatal("formData should be true but got false")
+		}
+
+		if formData["bucket"] != "author-images" {
+			t.Errorf("expected '%s' as bucket but got '%s'", "author-images", formData["bucket"])
+		}
+	})
+
+	t.Run("failed to get author presigned url", func(t *testing.T) {
+		mockService := &MockUploadService{
+			MockError: errors.New("minio is unreachable"),
+		}
+
+		handler := NewUploadHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/upload/authors/presigned-url", nil)
+		res := httptest.NewRecorder()
+
+		handler.GetAuthorPresignedURL(res, req)
+
+		// Check status code
+		if res.Code != http.StatusInternalServerError {
+			t.Errorf("expected status code of %d but got %d", http.StatusInternalServerError, res.Code)
+		}
+	})
+}
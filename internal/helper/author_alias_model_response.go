@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToAuthorAliasResponse(alias domain.AuthorAlias) web.AuthorAliasResponse {
+	return web.AuthorAliasResponse{
+		Id:        alias.Id,
+		AuthorId:  alias.AuthorId,
+		Name:      alias.Name,
+		CreatedAt: alias.CreatedAt,
+		UpdatedAt: alias.UpdatedAt,
+	}
+}
+
+func ToAuthorAliasesResponse(aliases []domain.AuthorAlias) []web.AuthorAliasResponse {
+	aliasResponses := []web.AuthorAliasResponse{}
+	for _, alias := range aliases {
+		aliasResponses = append(aliasResponses, ToAuthorAliasResponse(alias))
+	}
+	return aliasResponses
+}
@@ -7,21 +7,35 @@ import (
 
 func ToCreateAuthorResponse(author domain.Author) web.CreateAuthorResponse {
 	return web.CreateAuthorResponse{
-		Id:          author.Id,
-		FullName:    author.FullName,
-		Nationality: author.Nationality,
-		CreatedAt:   author.CreatedAt,
-		UpdatedAt:   author.UpdatedAt,
+		Id:            author.Id,
+		FullName:      author.FullName,
+		Nationality:   author.Nationality,
+		BirthDate:     author.BirthDate,
+		DeathDate:     author.DeathDate,
+		Bio:           author.Bio,
+		Website:       author.Website,
+		OpenLibraryId: author.OpenLibraryId,
+		WikidataId:    author.WikidataId,
+		PhotoKey:      author.PhotoKey,
+		CreatedAt:     author.CreatedAt,
+		UpdatedAt:     author.UpdatedAt,
 	}
 }
 
 func ToGetAuthorResponse(author domain.Author) web.GetAuthorResponse {
 	return web.GetAuthorResponse{
-		Id:          author.Id,
-		FullName:    author.FullName,
-		Nationality: author.Nationality,
-		CreatedAt:   author.CreatedAt,
-		UpdatedAt:   author.UpdatedAt,
+		Id:            author.Id,
+		FullName:      author.FullName,
+		Nationality:   author.Nationality,
+		BirthDate:     author.BirthDate,
+		DeathDate:     author.DeathDate,
+		Bio:           author.Bio,
+		Website:       author.Website,
+		OpenLibraryId: author.OpenLibraryId,
+		WikidataId:    author.WikidataId,
+		PhotoKey:      author.PhotoKey,
+		CreatedAt:     author.CreatedAt,
+		UpdatedAt:     author.UpdatedAt,
 	}
 }
 
@@ -35,9 +49,43 @@ func ToGetAuthorsResponse(authors []domain.Author) []web.GetAuthorResponse {
 
 func ToUpdateAuthorResponse(author domain.Author) web.UpdateAuthorResponse {
 	return web.UpdateAuthorResponse{
+		Id:            author.Id,
+		FullName:      author.FullName,
+		Nationality:   author.Nationality,
+		BirthDate:     author.BirthDate,
+		DeathDate:     author.DeathDate,
+		Bio:           author.Bio,
+		Website:       author.Website,
+		OpenLibraryId: author.OpenLibraryId,
+		WikidataId:    author.WikidataId,
+		PhotoKey:      author.PhotoKey,
+		CreatedAt:     author.CreatedAt,
+		UpdatedAt:     author.UpdatedAt,
+	}
+}
+
+func ToMergeAuthorsResponse(author domain.Author, aliases []domain.AuthorAlias, mergedIds []string, collisions []domain.BookCollision) web.MergeAuthorsResponse {
+	aliasNames := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		aliasNames = append(aliasNames, alias.Name)
+	}
+
+	collisionResponses := make([]web.BookCollisionResponse, 0, len(collisions))
+	for _, collision := range collisions {
+		collisionResponses = append(collisionResponses, web.BookCollisionResponse{
+			Name:          collision.Name,
+			BookId:        collision.BookIds[0],
+			MergedBookIds: collision.BookIds[1:],
+		})
+	}
+
+	return web.MergeAuthorsResponse{
 		Id:          author.Id,
 		FullName:    author.FullName,
 		Nationality: author.Nationality,
+		Aliases:     aliasNames,
+		MergedIds:   mergedIds,
+		Collisions:  collisionResponses,
 		CreatedAt:   author.CreatedAt,
 		UpdatedAt:   author.UpdatedAt,
 	}
@@ -0,0 +1,183 @@
+package helper
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+const (
+	ExportFormatJSON      = "json"
+	ExportFormatCSV       = "csv"
+	ExportFormatGoodreads = "goodreads"
+
+	// exportFlushEvery flushes CSV rows to the client in small chunks instead of buffering the file
+	exportFlushEvery = 100
+)
+
+var bookCSVHeader = []string{
+	"id", "name", "total_page", "current_page", "status", "completed_date",
+	"author_id", "author_name", "author_nationality", "created_at", "updated_at",
+}
+
+// goodreadsCSVHeader uses the column names the Goodreads importer and ParseShelfCSV read
+var goodreadsCSVHeader = []string{
+	"Title", "Author", "Number of Pages", "Exclusive Shelf", "Date Read", "Date Added",
+}
+
+// BookExportWriter writes books one by one, Close must be called to finish the document
+type BookExportWriter interface {
+	WriteBook(book domain.BookWithAuthor) error
+	Close() error
+}
+
+// ExportFormat returns the format to use, json is the default
+func ExportFormat(format string) string {
+	if format == "" {
+		return ExportFormatJSON
+	}
+
+	return format
+}
+
+// ExportContentType returns the Content-Type and file extension of an export format
+func ExportContentType(format string) (string, string) {
+	if ExportFormat(format) == ExportFormatJSON {
+		return "application/json", "json"
+	}
+
+	return "text/csv", "csv"
+}
+
+func NewBookExportWriter(format string, w io.Writer) BookExportWriter {
+	switch ExportFormat(format) {
+	case ExportFormatCSV:
+		return &bookCSVWriter{w: csv.NewWriter(w), header: bookCSVHeader, record: bookCSVRecord}
+	case ExportFormatGoodreads:
+		return &bookCSVWriter{w: csv.NewWriter(w), header: goodreadsCSVHeader, record: goodreadsCSVRecord}
+	default:
+		return &bookJSONWriter{w: w, encoder: json.NewEncoder(w)}
+	}
+}
+
+// bookJSONWriter writes the usual WebSuccessResponse envelope with data as an array
+type bookJSONWriter struct {
+	w       io.Writer
+	encoder *json.Encoder
+	count   int
+}
+
+func (writer *bookJSONWriter) WriteBook(book domain.BookWithAuthor) error {
+	separator := ","
+	if writer.count == 0 {
+		separator = `{"message":"Success export books","data":[`
+	}
+
+	if _, err := io.WriteString(writer.w, separator); err != nil {
+		return err
+	}
+	writer.count++
+
+	return writer.encoder.Encode(ToExportBookResponse(book))
+}
+
+func (writer *bookJSONWriter) Close() error {
+	closing := "]}\n"
+	if writer.count == 0 {
+		closing = `{"message":"Success export books","data":[]}` + "\n"
+	}
+
+	_, err := io.WriteString(writer.w, closing)
+	return err
+}
+
+type bookCSVWriter struct {
+	w      *csv.Writer
+	header []string
+	record func(book domain.BookWithAuthor) []string
+	count  int
+}
+
+func (writer *bookCSVWriter) WriteBook(book domain.BookWithAuthor) error {
+	if writer.count == 0 {
+		if err := writer.w.Write(writer.header); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.w.Write(writer.record(book)); err != nil {
+		return err
+	}
+	writer.count++
+
+	if writer.count%exportFlushEvery == 0 {
+		writer.w.Flush()
+		return writer.w.Error()
+	}
+
+	return nil
+}
+
+func (writer *bookCSVWriter) Close() error {
+	if writer.count == 0 {
+		if err := writer.w.Write(writer.header); err != nil {
+			return err
+		}
+	}
+
+	writer.w.Flush()
+	return writer.w.Error()
+}
+
+func bookCSVRecord(book domain.BookWithAuthor) []string {
+	return []string{
+		book.Id,
+		book.Name,
+		strconv.Itoa(book.TotalPage),
+		strconv.Itoa(book.CurrentPage),
+		book.Status,
+		book.CompletedDate,
+		book.AuthorId,
+		book.AuthorName,
+		book.AuthorNationality,
+		book.CreatedAt.Format(time.RFC3339),
+		book.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func goodreadsCSVRecord(book domain.BookWithAuthor) []string {
+	return []string{
+		book.Name,
+		book.AuthorName,
+		strconv.Itoa(book.TotalPage),
+		StatusToShelf(book.Status),
+		goodreadsDate(book.CompletedDate),
+		book.CreatedAt.Format("2006/01/02"),
+	}
+}
+
+// StatusToShelf maps a book status to its Goodreads exclusive shelf
+func StatusToShelf(status string) string {
+	switch status {
+	case "completed":
+		return "read"
+	case "reading":
+		return "currently-reading"
+	default:
+		return "to-read"
+	}
+}
+
+// goodreadsDate turns 2006-01-02 into the 2006/01/02 Goodreads uses
+func goodreadsDate(value string) string {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return value
+	}
+
+	return date.Format("2006/01/02")
+}
@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+var exportedBooks = []domain.BookWithAuthor{
+	{
+		Id:                "43723811-c8e3-4cba-85cc-142954064ae4",
+		Name:              "Dune",
+		TotalPage:         412,
+		CurrentPage:       412,
+		AuthorId:          "7d8a3f1c-2b4e-4f6a-9c1d-0e5b6a7c8d9f",
+		AuthorName:        "Frank Herbert",
+		AuthorNationality: "American",
+		Status:            "completed",
+		CompletedDate:     "2024-01-05",
+		CreatedAt:         time.Date(2023, 12, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2024, 1, 5, 8, 0, 0, 0, time.UTC),
+	},
+	{
+		Id:                "5b0f7e0a-3f58-4a36-9a3c-7a8d8f0f2d11",
+		Name:              "Children of Dune, \"Part 3\"",
+		TotalPage:         444,
+		AuthorId:          "7d8a3f1c-2b4e-4f6a-9c1d-0e5b6a7c8d9f",
+		AuthorName:        "Frank Herbert",
+		AuthorNationality: "American",
+		Status:            "plan_to_read",
+		CreatedAt:         time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC),
+	},
+}
+
+func writeBooks(t *testing.T, format string, books []domain.BookWithAuthor) string {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	writer := NewBookExportWriter(format, buf)
+	for _, book := range books {
+		if err := writer.WriteBook(book); err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	return buf.String()
+}
+
+func TestBookExportWriter(t *testing.T) {
+	t.Run("json export is a valid success response", func(t *testing.T) {
+		for _, books := range [][]domain.BookWithAuthor{exportedBooks, {}} {
+			var response struct {
+				Message string                   `json:"message"`
+				Data    []web.ExportBookResponse `json:"data"`
+			}
+
+			err := json.Unmarshal([]byte(writeBooks(t, "", books)), &response)
+			if err != nil {
+				t.Fatalf("expected valid JSON but got %v", err)
+			}
+
+			if len(response.Data) != len(books) {
+				t.Fatalf("expected %d books but got %d", len(books), len(response.Data))
+			}
+
+			if len(books) > 0 && response.Data[0].Author.FullName != "Frank Herbert" {
+				t.Errorf("expected author Frank Herbert but got %s", response.Data[0].Author.FullName)
+			}
+		}
+	})
+
+	t.Run("csv export has a header and a row per book", func(t *testing.T) {
+		lines := strings.Split(strings.TrimSpace(writeBooks(t, ExportFormatCSV, exportedBooks)), "\n")
+
+		if len(lines) != 3 {
+			t.Fatalf("expected 3 lines but got %d", len(lines))
+		}
+
+		if !strings.HasPrefix(lines[0], "id,name,total_page") {
+			t.Errorf("expected csv header but got %s", lines[0])
+		}
+
+		if !strings.Contains(lines[2], `"Children of Dune, ""Part 3"""`) {
+			t.Errorf("expected quoted name but got %s", lines[2])
+		}
+	})
+
+	t.Run("goodreads export can be imported again", func(t *testing.T) {
+		format, rows, err := ParseShelfCSV(strings.NewReader(writeBooks(t, ExportFormatGoodreads, exportedBooks)))
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if format != domain.ImportFormatGoodreads {
+			t.Errorf("expected format %s but got %s", domain.ImportFormatGoodreads, format)
+		}
+
+		if rows[0].Shelf != "read" || rows[0].DateRead != "2024-01-05" || rows[0].TotalPage != 412 {
+			t.Errorf("expected read on 2024-01-05 with 412 pages but got %+v", rows[0])
+		}
+
+		if rows[1].Shelf != "to-read" {
+			t.Errorf("expected to-read but got %s", rows[1].Shelf)
+		}
+	})
+}
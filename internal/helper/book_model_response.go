@@ -7,29 +7,47 @@ import (
 
 func ToCreateBookResponse(book domain.Book) web.CreateBookResponse {
 	return web.CreateBookResponse{
-		Id:            book.Id,
-		Name:          book.Name,
-		TotalPage:     book.TotalPage,
-		AuthorId:      book.AuthorId,
-		PhotoKey:      book.PhotoKey,
-		Status:        book.Status,
-		CompletedDate: book.CompletedDate,
-		CreatedAt:     book.CreatedAt,
-		UpdatedAt:     book.UpdatedAt,
+		Id:              book.Id,
+		Name:            book.Name,
+		TotalPage:       book.TotalPage,
+		CurrentPage:     book.CurrentPage,
+		AuthorId:        book.AuthorId,
+		PhotoKey:        book.PhotoKey,
+		Status:          book.Status,
+		CompletedDate:   book.CompletedDate,
+		Isbn:            book.Isbn,
+		Publisher:       book.Publisher,
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		SeriesId:        book.SeriesId,
+		SeriesPosition:  book.SeriesPosition,
+		Contributors:    ToBookContributorResponses(book.Contributors),
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
 	}
 }
 
 func ToGetBookResponse(book domain.BookWithURL) web.GetBookResponse {
 	return web.GetBookResponse{
-		Id:            book.Id,
-		Name:          book.Name,
-		TotalPage:     book.TotalPage,
-		AuthorId:      book.AuthorId,
-		PhotoURL:      book.PhotoURL,
-		Status:        book.Status,
-		CompletedDate: book.CompletedDate,
-		CreatedAt:     book.CreatedAt,
-		UpdatedAt:     book.UpdatedAt,
+		Id:              book.Id,
+		Name:            book.Name,
+		TotalPage:       book.TotalPage,
+		CurrentPage:     book.CurrentPage,
+		AuthorId:        book.AuthorId,
+		PhotoURL:        book.PhotoURL,
+		Status:          book.Status,
+		CompletedDate:   book.CompletedDate,
+		Isbn:            book.Isbn,
+		Publisher:       book.Publisher,
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		SeriesId:        book.SeriesId,
+		SeriesPosition:  book.SeriesPosition,
+		Rating:          book.Rating,
+		Contributors:    ToBookContributorResponses(book.Contributors),
+		Tags:            ToBookTagResponses(book.Tags),
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
 	}
 }
 
@@ -43,14 +61,80 @@ func ToGetBooksResponse(books []domain.BookWithURL) []web.GetBookResponse {
 
 func ToUpdateBookResponse(book domain.Book) web.UpdateBookResponse {
 	return web.UpdateBookResponse{
-		Id:            book.Id,
-		Name:          book.Name,
-		TotalPage:     book.TotalPage,
-		AuthorId:      book.AuthorId,
-		PhotoKey:      book.PhotoKey,
-		Status:        book.Status,
-		CompletedDate: book.CompletedDate,
-		CreatedAt:     book.CreatedAt,
-		UpdatedAt:     book.UpdatedAt,
+		Id:              book.Id,
+		Name:            book.Name,
+		TotalPage:       book.TotalPage,
+		CurrentPage:     book.CurrentPage,
+		AuthorId:        book.AuthorId,
+		PhotoKey:        book.PhotoKey,
+		Status:          book.Status,
+		CompletedDate:   book.CompletedDate,
+		Isbn:            book.Isbn,
+		Publisher:       book.Publisher,
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		SeriesId:        book.SeriesId,
+		SeriesPosition:  book.SeriesPosition,
+		Contributors:    ToBookContributorResponses(book.Contributors),
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
+	}
+}
+
+func ToBookContributorResponses(contributors []domain.BookContributor) []web.BookContributorResponse {
+	contributorResponses := []web.BookContributorResponse{}
+	for _, contributor := range contributors {
+		contributorResponses = append(contributorResponses, web.BookContributorResponse{
+			AuthorId:    contributor.AuthorId,
+			FullName:    contributor.FullName,
+			Role:        contributor.Role,
+			Position:    contributor.Position,
+			AliasId:     contributor.AliasId,
+			PublishedAs: contributor.PublishedAs,
+		})
+	}
+	return contributorResponses
+}
+
+func ToExportBookResponse(book domain.BookWithAuthor) web.ExportBookResponse {
+	return web.ExportBookResponse{
+		Id:              book.Id,
+		Name:            book.Name,
+		TotalPage:       book.TotalPage,
+		CurrentPage:     book.CurrentPage,
+		Status:          book.Status,
+		CompletedDate:   book.CompletedDate,
+		Isbn:            book.Isbn,
+		Publisher:       book.Publisher,
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		Author: web.ExportAuthorResponse{
+			Id:          book.AuthorId,
+			FullName:    book.AuthorName,
+			Nationality: book.AuthorNationality,
+		},
+		CreatedAt: book.CreatedAt,
+		UpdatedAt: book.UpdatedAt,
+	}
+}
+
+func ToLookupBookResponse(metadata domain.BookMetadata, authors []web.LookupAuthorResponse) web.LookupBookResponse {
+	book := web.CreateBookRequest{
+		Name:            metadata.Title,
+		TotalPage:       metadata.TotalPage,
+		Status:          "plan_to_read",
+		Isbn:            metadata.Isbn,
+		Publisher:       metadata.Publisher,
+		PublicationYear: metadata.PublicationYear,
+		Language:        metadata.Language,
+	}
+
+	if len(authors) > 0 {
+		book.AuthorId = authors[0].AuthorId
+	}
+
+	return web.LookupBookResponse{
+		Book:    book,
+		Authors: authors,
 	}
 }
@@ -0,0 +1,18 @@
+package helper
+
+import "context"
+
+type contextKey string
+
+const userIdKey contextKey = "user_id"
+
+// WithUserId returns a copy of ctx that carries the authenticated user id
+func WithUserId(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIdKey, userId)
+}
+
+// UserIdFromContext returns the authenticated user id stored by the auth middleware
+func UserIdFromContext(ctx context.Context) (string, bool) {
+	userId, ok := ctx.Value(userIdKey).(string)
+	return userId, ok && userId != ""
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToGoalResponse(goal domain.Goal) web.GoalResponse {
+	goalResponse := web.GoalResponse{
+		Id:        goal.Id,
+		Metric:    goal.Metric,
+		Target:    goal.Target,
+		Period:    goal.Period,
+		Year:      goal.StartDate.Year(),
+		StartDate: goal.StartDate.Format(dateLayout),
+		EndDate:   goal.EndDate.Format(dateLayout),
+		CreatedAt: goal.CreatedAt,
+		UpdatedAt: goal.UpdatedAt,
+	}
+
+	switch goal.Period {
+	case domain.GoalPeriodQuarter:
+		goalResponse.Quarter = (int(goal.StartDate.Month())-1)/3 + 1
+	case domain.GoalPeriodMonth:
+		goalResponse.Month = int(goal.StartDate.Month())
+	}
+
+	return goalResponse
+}
+
+func ToGoalsResponse(goals []domain.Goal) []web.GoalResponse {
+	goalResponses := []web.GoalResponse{}
+	for _, goal := range goals {
+		goalResponses = append(goalResponses, ToGoalResponse(goal))
+	}
+	return goalResponses
+}
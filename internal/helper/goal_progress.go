@@ -0,0 +1,95 @@
+package helper
+
+import (
+	"math"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+// dateLayout is the format of the DATE columns in requests and responses
+const dateLayout = "2006-01-02"
+
+// GoalPeriodDates returns the first and the last day of a year, a quarter or a month
+func GoalPeriodDates(period string, year, quarter, month int) (time.Time, time.Time) {
+	var start time.Time
+	var months int
+
+	switch period {
+	case domain.GoalPeriodQuarter:
+		start = time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC)
+		months = 3
+	case domain.GoalPeriodMonth:
+		start = time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		months = 1
+	default:
+		start = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		months = 12
+	}
+
+	return start, start.AddDate(0, months, -1)
+}
+
+// ToGoalProgressResponse compares what was read in the period of a goal with the even pace that reaches the
+// target on the last day. today counts as elapsed, so the expected amount on the last day is the target
+func ToGoalProgressResponse(goal domain.Goal, totals domain.ReadingTotals, today time.Time) web.GoalProgressResponse {
+	current := totals.Books
+	if goal.Metric == domain.GoalMetricPages {
+		current = totals.Pages
+	}
+
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	daysTotal := daysBetween(goal.StartDate, goal.EndDate) + 1
+	daysElapsed := min(max(daysBetween(goal.StartDate, today)+1, 0), daysTotal)
+	daysLeft := daysTotal - daysElapsed
+
+	progress := web.GoalProgressResponse{
+		GoalId:              goal.Id,
+		Metric:              goal.Metric,
+		Target:              goal.Target,
+		StartDate:           goal.StartDate.Format(dateLayout),
+		EndDate:             goal.EndDate.Format(dateLayout),
+		Current:             current,
+		Remaining:           max(goal.Target-current, 0),
+		Percent:             roundTo(float64(current)*100/float64(goal.Target), 1),
+		Expected:            roundTo(float64(goal.Target)*float64(daysElapsed)/float64(daysTotal), 2),
+		DaysTotal:           daysTotal,
+		DaysElapsed:         daysElapsed,
+		DaysLeft:            daysLeft,
+		ExpectedPacePerWeek: roundTo(float64(goal.Target)/float64(daysTotal)*7, 2),
+	}
+
+	if daysElapsed > 0 {
+		progress.ActualPacePerWeek = roundTo(float64(current)/float64(daysElapsed)*7, 2)
+	}
+
+	// On the last day the rest has to be read today
+	if !today.After(goal.EndDate) {
+		progress.RequiredPacePerWeek = roundTo(float64(progress.Remaining)/float64(max(daysLeft, 1))*7, 2)
+	}
+
+	switch {
+	case current >= goal.Target:
+		progress.Status = web.GoalStatusAchieved
+	case today.After(goal.EndDate):
+		progress.Status = web.GoalStatusMissed
+	case daysElapsed == 0:
+		progress.Status = web.GoalStatusNotStarted
+	case float64(current) >= progress.Expected:
+		progress.Status = web.GoalStatusOnTrack
+	default:
+		progress.Status = web.GoalStatusBehind
+	}
+
+	return progress
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func roundTo(value float64, decimals int) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return math.Round(value*scale) / scale
+}
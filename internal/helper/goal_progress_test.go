@@ -0,0 +1,128 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func TestGoalPeriodDates(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Period        string
+		Quarter       int
+		Month         int
+		ExpectedStart string
+		ExpectedEnd   string
+	}{
+		{
+			Name:          "year",
+			Period:        domain.GoalPeriodYear,
+			ExpectedStart: "2024-01-01",
+			ExpectedEnd:   "2024-12-31",
+		},
+		{
+			Name:          "quarter",
+			Period:        domain.GoalPeriodQuarter,
+			Quarter:       3,
+			ExpectedStart: "2024-07-01",
+			ExpectedEnd:   "2024-09-30",
+		},
+		{
+			Name:          "leap february",
+			Period:        domain.GoalPeriodMonth,
+			Month:         2,
+			ExpectedStart: "2024-02-01",
+			ExpectedEnd:   "2024-02-29",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			start, end := GoalPeriodDates(c.Period, 2024, c.Quarter, c.Month)
+
+			if start.Format(dateLayout) != c.ExpectedStart || end.Format(dateLayout) != c.ExpectedEnd {
+				t.Errorf("expected %s to %s but got %s to %s", c.ExpectedStart, c.ExpectedEnd, start.Format(dateLayout), end.Format(dateLayout))
+			}
+		})
+	}
+}
+
+func TestToGoalProgressResponse(t *testing.T) {
+	start, end := GoalPeriodDates(domain.GoalPeriodYear, 2026, 0, 0)
+	goal := domain.Goal{
+		Id:        "0b7e2c4a-5d1f-4e3b-9a8c-6f2d1e0c9b8a",
+		Metric:    domain.GoalMetricBooks,
+		Period:    domain.GoalPeriodYear,
+		Target:    24,
+		StartDate: start,
+		EndDate:   end,
+	}
+
+	t.Run("behind the expected pace", func(t *testing.T) {
+		// 2026-07-02 is day 183 of 365, half of 24 books is expected by then
+		progress := ToGoalProgressResponse(goal, domain.ReadingTotals{Books: 9, Pages: 2800}, time.Date(2026, time.July, 2, 18, 30, 0, 0, time.UTC))
+
+		if progress.Current != 9 || progress.Remaining != 15 {
+			t.Errorf("expected 9 read and 15 remaining but got %d and %d", progress.Current, progress.Remaining)
+		}
+
+		if progress.DaysTotal != 365 || progress.DaysElapsed != 183 || progress.DaysLeft != 182 {
+			t.Errorf("expected 365, 183 and 182 days but got %d, %d and %d", progress.DaysTotal, progress.DaysElapsed, progress.DaysLeft)
+		}
+
+		if progress.Expected != 12.03 {
+			t.Errorf("expected 12.03 books by now but got %v", progress.Expected)
+		}
+
+		if progress.ExpectedPacePerWeek != 0.46 || progress.ActualPacePerWeek != 0.34 || progress.RequiredPacePerWeek != 0.58 {
+			t.Errorf("expected paces 0.46, 0.34 and 0.58 but got %v, %v and %v", progress.ExpectedPacePerWeek, progress.ActualPacePerWeek, progress.RequiredPacePerWeek)
+		}
+
+		if progress.Status != web.GoalStatusBehind {
+			t.Errorf("expected status %s but got %s", web.GoalStatusBehind, progress.Status)
+		}
+	})
+
+	t.Run("pages goal ahead of the expected pace", func(t *testing.T) {
+		pagesGoal := goal
+		pagesGoal.Metric = domain.GoalMetricPages
+		pagesGoal.Target = 5000
+
+		progress := ToGoalProgressResponse(pagesGoal, domain.ReadingTotals{Books: 9, Pages: 2800}, time.Date(2026, time.July, 2, 0, 0, 0, 0, time.UTC))
+
+		if progress.Current != 2800 || progress.Percent != 56 {
+			t.Errorf("expected 2800 pages and 56 percent but got %d and %v", progress.Current, progress.Percent)
+		}
+
+		if progress.Status != web.GoalStatusOnTrack {
+			t.Errorf("expected status %s but got %s", web.GoalStatusOnTrack, progress.Status)
+		}
+	})
+
+	t.Run("goal that has not started", func(t *testing.T) {
+		progress := ToGoalProgressResponse(goal, domain.ReadingTotals{}, time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC))
+
+		if progress.DaysElapsed != 0 || progress.ActualPacePerWeek != 0 || progress.Status != web.GoalStatusNotStarted {
+			t.Errorf("expected a goal that has not started but got %+v", progress)
+		}
+	})
+
+	t.Run("goal that ended below target", func(t *testing.T) {
+		progress := ToGoalProgressResponse(goal, domain.ReadingTotals{Books: 20}, time.Date(2027, time.January, 3, 0, 0, 0, 0, time.UTC))
+
+		if progress.DaysLeft != 0 || progress.RequiredPacePerWeek != 0 || progress.Status != web.GoalStatusMissed {
+			t.Errorf("expected a missed goal but got %+v", progress)
+		}
+	})
+
+	t.Run("goal that reached its target", func(t *testing.T) {
+		progress := ToGoalProgressResponse(goal, domain.ReadingTotals{Books: 25}, time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))
+
+		if progress.Remaining != 0 || progress.RequiredPacePerWeek != 0 || progress.Status != web.GoalStatusAchieved {
+			t.Errorf("expected an achieved goal but got %+v", progress)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToHighlightsResponse(highlights []domain.Highlight) []web.HighlightResponse {
+	highlightResponses := []web.HighlightResponse{}
+	for _, highlight := range highlights {
+		highlightResponses = append(highlightResponses, web.HighlightResponse{
+			Id:        highlight.Id,
+			BookId:    highlight.BookId,
+			Type:      highlight.Type,
+			Text:      highlight.Text,
+			Location:  highlight.Location,
+			Page:      highlight.Page,
+			ClippedAt: highlight.ClippedAt,
+			CreatedAt: highlight.CreatedAt,
+		})
+	}
+	return highlightResponses
+}
@@ -0,0 +1,63 @@
+package helper
+
+import "strings"
+
+// NormalizeISBN removes hyphens and spaces so ISBNs are stored and compared as plain digits
+func NormalizeISBN(isbn string) string {
+	isbn = strings.NewReplacer("-", "", " ", "").Replace(isbn)
+	return strings.ToUpper(isbn)
+}
+
+// IsValidISBN reports whether isbn is an ISBN-10 or ISBN-13 with a correct check digit,
+// hyphens and spaces are ignored
+func IsValidISBN(isbn string) bool {
+	isbn = NormalizeISBN(isbn)
+
+	switch len(isbn) {
+	case 10:
+		return isValidISBN10(isbn)
+	case 13:
+		return isValidISBN13(isbn)
+	default:
+		return false
+	}
+}
+
+// isValidISBN10 checks that the weighted sum 10*d1 + 9*d2 + ... + 1*d10 is divisible by 11,
+// the check digit may be X for 10
+func isValidISBN10(isbn string) bool {
+	sum := 0
+	for i, r := range isbn {
+		var digit int
+		switch {
+		case r >= '0' && r <= '9':
+			digit = int(r - '0')
+		case r == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+
+		sum += digit * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+// isValidISBN13 checks that the digits weighted 1, 3, 1, 3, ... sum to a multiple of 10
+func isValidISBN13(isbn string) bool {
+	sum := 0
+	for i, r := range isbn {
+		if r < '0' || r > '9' {
+			return false
+		}
+
+		digit := int(r - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
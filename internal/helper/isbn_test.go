@@ -0,0 +1,33 @@
+package helper
+
+import "testing"
+
+func TestIsValidISBN(t *testing.T) {
+	cases := []struct {
+		Isbn  string
+		Valid bool
+	}{
+		{Isbn: "9780441172719", Valid: true},
+		{Isbn: "978-0-441-17271-9", Valid: true},
+		{Isbn: "0618260307", Valid: true},
+		{Isbn: "0-8044-2957-X", Valid: true},
+		{Isbn: "0-8044-2957-x", Valid: true},
+		{Isbn: "9780441172718", Valid: false},
+		{Isbn: "0618260308", Valid: false},
+		{Isbn: "X618260307", Valid: false},
+		{Isbn: "97804411727", Valid: false},
+		{Isbn: "", Valid: false},
+	}
+
+	for _, c := range cases {
+		if IsValidISBN(c.Isbn) != c.Valid {
+			t.Errorf("expected IsValidISBN(%q) to be %t", c.Isbn, c.Valid)
+		}
+	}
+}
+
+func TestNormalizeISBN(t *testing.T) {
+	if NormalizeISBN("0-8044-2957-x") != "080442957X" {
+		t.Errorf("expected 080442957X but got %s", NormalizeISBN("0-8044-2957-x"))
+	}
+}
@@ -0,0 +1,180 @@
+package helper
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+var ErrNoClippings = errors.New("file is not a Kindle My Clippings.txt file")
+
+// clippingSeparator ends every entry of My Clippings.txt
+const clippingSeparator = "=========="
+
+var (
+	clippingPagePattern     = regexp.MustCompile(`(?i)\bpage\s+([0-9]+)`)
+	clippingLocationPattern = regexp.MustCompile(`(?i)\b(?:location|loc\.)\s+([0-9]+(?:-[0-9]+)?)`)
+)
+
+// clippingDateLayouts are the "Added on" formats of the US and UK English Kindle firmwares
+var clippingDateLayouts = []string{
+	"Monday, January 2, 2006 3:04:05 PM",
+	"Monday, 2 January 2006 15:04:05",
+	"Monday, January 2, 2006, 3:04 PM",
+	"Monday, 2 January 2006 15:04",
+}
+
+// ParseKindleClippings reads every entry of a Kindle My Clippings.txt file. An entry is a title line with the
+// author in parentheses, a metadata line, a blank line and the clipped text, followed by the separator
+func ParseKindleClippings(r io.Reader) ([]domain.Clipping, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	clippings := []domain.Clipping{}
+	entry := []string{}
+	entryLine := 1
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		// Every entry starts with a BOM on some firmwares, not only the first one
+		text := strings.TrimRight(strings.TrimPrefix(scanner.Text(), "\ufeff"), "\r")
+
+		if strings.TrimSpace(text) != clippingSeparator {
+			entry = append(entry, text)
+			continue
+		}
+
+		if clipping, ok := parseClipping(entry); ok {
+			clipping.Line = entryLine
+			clippings = append(clippings, clipping)
+		}
+
+		entry = entry[:0]
+		entryLine = line + 1
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(clippings) == 0 {
+		return nil, ErrNoClippings
+	}
+
+	return clippings, nil
+}
+
+// ClippingFingerprint identifies a clipping of a book, the same highlight exported twice has the same fingerprint
+func ClippingFingerprint(clipping domain.Clipping) string {
+	sum := sha256.Sum256([]byte(clipping.Kind + "\x00" + clipping.Location + "\x00" + strconv.Itoa(clipping.Page) + "\x00" + normalizeSpaces(clipping.Text)))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseClipping(entry []string) (domain.Clipping, bool) {
+	// Skip blank lines before the title
+	for len(entry) > 0 && strings.TrimSpace(entry[0]) == "" {
+		entry = entry[1:]
+	}
+
+	if len(entry) < 2 || !strings.HasPrefix(strings.TrimSpace(entry[1]), "-") {
+		return domain.Clipping{}, false
+	}
+
+	title, author := splitClippingTitle(strings.TrimSpace(entry[0]))
+	clipping := domain.Clipping{
+		Title:      title,
+		AuthorName: author,
+		Text:       strings.TrimSpace(strings.Join(entry[2:], "\n")),
+	}
+
+	// - Your Highlight on page 12 | Location 170-172 | Added on Sunday, 5 March 2023 10:11:12
+	for i, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(entry[1]), "-"), "|") {
+		part = strings.TrimSpace(part)
+
+		if i == 0 {
+			clipping.Kind = clippingKind(part)
+		}
+
+		if match := clippingPagePattern.FindStringSubmatch(part); match != nil {
+			clipping.Page, _ = strconv.Atoi(match[1])
+		}
+
+		if match := clippingLocationPattern.FindStringSubmatch(part); match != nil {
+			clipping.Location = match[1]
+		}
+
+		if added, ok := strings.CutPrefix(part, "Added on "); ok {
+			for _, layout := range clippingDateLayouts {
+				if date, err := time.Parse(layout, added); err == nil {
+					clipping.ClippedAt = &date
+					break
+				}
+			}
+		}
+	}
+
+	return clipping, clipping.Title != ""
+}
+
+func clippingKind(part string) string {
+	part = strings.ToLower(part)
+
+	switch {
+	case strings.Contains(part, "highlight"):
+		return domain.ClippingKindHighlight
+	case strings.Contains(part, "note"):
+		return domain.ClippingKindNote
+	case strings.Contains(part, "bookmark"):
+		return domain.ClippingKindBookmark
+	default:
+		return ""
+	}
+}
+
+// splitClippingTitle splits "Title (Author)" on the last balanced parentheses, titles may contain parentheses too
+func splitClippingTitle(value string) (string, string) {
+	if !strings.HasSuffix(value, ")") {
+		return normalizeSpaces(value), ""
+	}
+
+	depth := 0
+	for i := len(value) - 1; i >= 0; i-- {
+		switch value[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				title := normalizeSpaces(value[:i])
+				if title == "" {
+					return normalizeSpaces(value), ""
+				}
+				return title, clippingAuthor(value[i+1 : len(value)-1])
+			}
+		}
+	}
+
+	return normalizeSpaces(value), ""
+}
+
+// clippingAuthor keeps the main author and turns "Orwell, George" into "George Orwell"
+func clippingAuthor(value string) string {
+	author, _, _ := strings.Cut(value, ";")
+
+	last, first, found := strings.Cut(author, ",")
+	if found && !strings.Contains(first, ",") && strings.TrimSpace(first) != "" {
+		author = first + " " + last
+	}
+
+	return NormalizeName(author)
+}
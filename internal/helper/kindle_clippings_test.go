@@ -0,0 +1,111 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+const myClippings = "\ufeffSapiens: A Brief History of Humankind (Harari, Yuval Noah)\r\n" +
+	"- Your Highlight on page 12 | Location 170-172 | Added on Sunday, 5 March 2023 10:11:12\r\n" +
+	"\r\n" +
+	"The Cognitive Revolution kick-started history.\r\n" +
+	"==========\r\n" +
+	"\ufeffThe Hobbit (The Lord of the Rings, #0) (J.R.R. Tolkien)\r\n" +
+	"- Your Bookmark on Location 88 | Added on Monday, March 6, 2023 9:01:02 PM\r\n" +
+	"\r\n" +
+	"\r\n" +
+	"==========\r\n" +
+	"\ufeffThe Hobbit (The Lord of the Rings, #0) (J.R.R. Tolkien)\r\n" +
+	"- Your Note on Location 90 | Added on Monday, March 6, 2023 9:05:00 PM\r\n" +
+	"\r\n" +
+	"Compare with the film\r\n" +
+	"second line\r\n" +
+	"==========\r\n"
+
+func TestParseKindleClippings(t *testing.T) {
+	t.Run("parse my clippings", func(t *testing.T) {
+		clippings, err := ParseKindleClippings(strings.NewReader(myClippings))
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if len(clippings) != 3 {
+			t.Fatalf("expected 3 clippings but got %d", len(clippings))
+		}
+
+		highlight := clippings[0]
+		if highlight.Line != 1 || highlight.Kind != domain.ClippingKindHighlight {
+			t.Errorf("expected highlight at line 1 but got %s at line %d", highlight.Kind, highlight.Line)
+		}
+
+		if highlight.Title != "Sapiens: A Brief History of Humankind" || highlight.AuthorName != "Yuval Noah Harari" {
+			t.Errorf("expected Sapiens by Yuval Noah Harari but got %s by %s", highlight.Title, highlight.AuthorName)
+		}
+
+		if highlight.Page != 12 || highlight.Location != "170-172" {
+			t.Errorf("expected page 12 location 170-172 but got page %d location %s", highlight.Page, highlight.Location)
+		}
+
+		expectedDate := time.Date(2023, time.March, 5, 10, 11, 12, 0, time.UTC)
+		if highlight.ClippedAt == nil || !highlight.ClippedAt.Equal(expectedDate) {
+			t.Errorf("expected clipped at %v but got %v", expectedDate, highlight.ClippedAt)
+		}
+
+		if highlight.Text != "The Cognitive Revolution kick-started history." {
+			t.Errorf("expected highlight text but got '%s'", highlight.Text)
+		}
+
+		bookmark := clippings[1]
+		if bookmark.Kind != domain.ClippingKindBookmark || bookmark.Text != "" || bookmark.Line != 6 {
+			t.Errorf("expected empty bookmark at line 6 but got %+v", bookmark)
+		}
+
+		note := clippings[2]
+		if note.Title != "The Hobbit (The Lord of the Rings, #0)" || note.AuthorName != "J.R.R. Tolkien" {
+			t.Errorf("expected The Hobbit (The Lord of the Rings, #0) by J.R.R. Tolkien but got %s by %s", note.Title, note.AuthorName)
+		}
+
+		if note.Kind != domain.ClippingKindNote || note.Text != "Compare with the film\nsecond line" {
+			t.Errorf("expected multi line note but got %s '%s'", note.Kind, note.Text)
+		}
+
+		if note.ClippedAt == nil || note.ClippedAt.Hour() != 21 {
+			t.Errorf("expected note clipped at 21:05 but got %v", note.ClippedAt)
+		}
+	})
+
+	t.Run("parse file without clippings", func(t *testing.T) {
+		_, err := ParseKindleClippings(strings.NewReader("Title,Author\nDune,Frank Herbert\n"))
+		if !errors.Is(err, ErrNoClippings) {
+			t.Errorf("expected %v but got %v", ErrNoClippings, err)
+		}
+	})
+}
+
+func TestClippingFingerprint(t *testing.T) {
+	clipping := domain.Clipping{
+		Kind:     domain.ClippingKindHighlight,
+		Location: "170-172",
+		Page:     12,
+		Text:     "The Cognitive Revolution kick-started history.",
+	}
+
+	reexported := clipping
+	reexported.Line = 120
+	reexported.Text = "The Cognitive Revolution  kick-started history.\n"
+
+	if ClippingFingerprint(clipping) != ClippingFingerprint(reexported) {
+		t.Error("expected the same clipping to have the same fingerprint")
+	}
+
+	extended := clipping
+	extended.Location = "170-175"
+
+	if ClippingFingerprint(clipping) == ClippingFingerprint(extended) {
+		t.Error("expected a clipping at another location to have another fingerprint")
+	}
+}
@@ -0,0 +1,140 @@
+package helper
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+var ErrNoAnnotations = errors.New("file is not a Kobo annotation export")
+
+// koboPublication and koboAnnotation are the elements of the Adobe annotation set a Kobo eReader exports
+// for a book, the elements are matched by local name so the dc namespace does not matter
+type koboPublication struct {
+	Title   string `xml:"title"`
+	Creator string `xml:"creator"`
+}
+
+type koboAnnotation struct {
+	Date   string `xml:"date"`
+	Target struct {
+		Fragment struct {
+			Progress string `xml:"progress,attr"`
+			Text     string `xml:"text"`
+		} `xml:"fragment"`
+	} `xml:"target"`
+	Content struct {
+		Text string `xml:"text"`
+	} `xml:"content"`
+}
+
+// ParseKoboAnnotations reads the annotations of a Kobo .annot export. The highlighted text of an annotation
+// is a highlight and its note is a note at the same location, the location is the progress through the book
+// such as 42.5%. Line is the line of the annotation element. An annotation without either is a bookmark
+func ParseKoboAnnotations(r io.Reader) ([]domain.Clipping, error) {
+	decoder := xml.NewDecoder(r)
+
+	publication := koboPublication{}
+	clippings := []domain.Clipping{}
+	isAnnotationSet := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrNoAnnotations
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "annotationSet":
+			isAnnotationSet = true
+		case "publication":
+			if err := decoder.DecodeElement(&publication, &start); err != nil {
+				return nil, ErrNoAnnotations
+			}
+		case "annotation":
+			line, _ := decoder.InputPos()
+
+			annotation := koboAnnotation{}
+			if err := decoder.DecodeElement(&annotation, &start); err != nil {
+				return nil, ErrNoAnnotations
+			}
+
+			clippings = append(clippings, koboClippings(line, annotation)...)
+		}
+	}
+
+	title := normalizeSpaces(publication.Title)
+	if !isAnnotationSet || title == "" || len(clippings) == 0 {
+		return nil, ErrNoAnnotations
+	}
+
+	// Every annotation belongs to the publication of the file
+	author := ""
+	if creator := strings.TrimSpace(publication.Creator); creator != "" {
+		author = clippingAuthor(creator)
+	}
+
+	for i := range clippings {
+		clippings[i].Title = title
+		clippings[i].AuthorName = author
+	}
+
+	return clippings, nil
+}
+
+func koboClippings(line int, annotation koboAnnotation) []domain.Clipping {
+	clipping := domain.Clipping{
+		Line:     line,
+		Location: koboLocation(annotation.Target.Fragment.Progress),
+	}
+
+	if date, err := time.Parse(time.RFC3339, strings.TrimSpace(annotation.Date)); err == nil {
+		date = date.UTC()
+		clipping.ClippedAt = &date
+	}
+
+	highlight := strings.TrimSpace(annotation.Target.Fragment.Text)
+	note := strings.TrimSpace(annotation.Content.Text)
+
+	if highlight == "" && note == "" {
+		clipping.Kind = domain.ClippingKindBookmark
+		return []domain.Clipping{clipping}
+	}
+
+	clippings := []domain.Clipping{}
+	if highlight != "" {
+		clipping.Kind = domain.ClippingKindHighlight
+		clipping.Text = highlight
+		clippings = append(clippings, clipping)
+	}
+	if note != "" {
+		clipping.Kind = domain.ClippingKindNote
+		clipping.Text = note
+		clippings = append(clippings, clipping)
+	}
+
+	return clippings
+}
+
+// koboLocation turns the progress of a fragment, a fraction of the book, into a percentage
+func koboLocation(progress string) string {
+	value, err := strconv.ParseFloat(strings.TrimSpace(progress), 64)
+	if err != nil || value < 0 || value > 1 {
+		return ""
+	}
+
+	return strconv.FormatFloat(value*100, 'f', 1, 64) + "%"
+}
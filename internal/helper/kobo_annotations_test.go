@@ -0,0 +1,92 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+const koboAnnotations = `<?xml version="1.0" encoding="utf-8"?>
+<annotationSet xmlns:xhtml="http://www.w3.org/1999/xhtml" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns="http://ns.adobe.com/digitaleditions/annotations">
+  <publication>
+    <dc:identifier>urn:isbn:9780062316097</dc:identifier>
+    <dc:title>Sapiens:  A Brief History of Humankind</dc:title>
+    <dc:creator>Harari, Yuval Noah</dc:creator>
+  </publication>
+  <annotation>
+    <dc:identifier>urn:uuid:4f7e2b1c-9a8d-4e6f-b5c4-3d2e1f0a9b8c</dc:identifier>
+    <dc:date>2023-03-05T17:11:12+07:00</dc:date>
+    <target>
+      <fragment start="OEBPS/ch01.xhtml#point(/1/4/2/1:0)" end="OEBPS/ch01.xhtml#point(/1/4/2/1:46)" progress="0.425">
+        <text>The Cognitive Revolution kick-started history.</text>
+      </fragment>
+    </target>
+    <content>
+      <dc:date>2023-03-05T17:12:00+07:00</dc:date>
+      <text>Compare with Guns, Germs, and Steel</text>
+    </content>
+  </annotation>
+  <annotation>
+    <dc:date>2023-03-06T09:00:00Z</dc:date>
+    <target>
+      <fragment start="OEBPS/ch02.xhtml#point(/1/4/2/1:0)" progress="0.5"/>
+    </target>
+  </annotation>
+</annotationSet>
+`
+
+func TestParseKoboAnnotations(t *testing.T) {
+	t.Run("parse annotations", func(t *testing.T) {
+		clippings, err := ParseKoboAnnotations(strings.NewReader(koboAnnotations))
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if len(clippings) != 3 {
+			t.Fatalf("expected 3 clippings but got %d", len(clippings))
+		}
+
+		highlight := clippings[0]
+		if highlight.Kind != domain.ClippingKindHighlight || highlight.Text != "The Cognitive Revolution kick-started history." {
+			t.Errorf("expected the highlighted text but got %s '%s'", highlight.Kind, highlight.Text)
+		}
+
+		if highlight.Title != "Sapiens: A Brief History of Humankind" || highlight.AuthorName != "Yuval Noah Harari" {
+			t.Errorf("expected Sapiens by Yuval Noah Harari but got %s by %s", highlight.Title, highlight.AuthorName)
+		}
+
+		if highlight.Location != "42.5%" || highlight.Page != 0 {
+			t.Errorf("expected location 42.5%% without a page but got location %s page %d", highlight.Location, highlight.Page)
+		}
+
+		expectedDate := time.Date(2023, time.March, 5, 10, 11, 12, 0, time.UTC)
+		if highlight.ClippedAt == nil || !highlight.ClippedAt.Equal(expectedDate) {
+			t.Errorf("expected clipped at %v but got %v", expectedDate, highlight.ClippedAt)
+		}
+
+		note := clippings[1]
+		if note.Kind != domain.ClippingKindNote || note.Text != "Compare with Guns, Germs, and Steel" || note.Location != highlight.Location {
+			t.Errorf("expected the note at the location of its highlight but got %s '%s' at %s", note.Kind, note.Text, note.Location)
+		}
+
+		if note.Line != highlight.Line || note.Line == 0 {
+			t.Errorf("expected the note on the line of its annotation but got %d and %d", note.Line, highlight.Line)
+		}
+
+		if bookmark := clippings[2]; bookmark.Kind != domain.ClippingKindBookmark || bookmark.Location != "50.0%" {
+			t.Errorf("expected a bookmark at 50.0%% but got %s at %s", bookmark.Kind, bookmark.Location)
+		}
+	})
+
+	t.Run("not an annotation export", func(t *testing.T) {
+		for _, file := range []string{"", myClippings, "<html><body>Dune</body></html>", "<annotationSet><annotation>"} {
+			_, err := ParseKoboAnnotations(strings.NewReader(file))
+			if !errors.Is(err, ErrNoAnnotations) {
+				t.Errorf("expected ErrNoAnnotations but got %v", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package helper
+
+import "golang.org/x/text/unicode/norm"
+
+// NormalizeName puts a name in Unicode NFC and collapses its whitespace, so "García" typed with a combining accent
+// and with a precomposed one is stored, compared and searched as the same name
+func NormalizeName(value string) string {
+	return normalizeSpaces(norm.NFC.String(value))
+}
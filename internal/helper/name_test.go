@@ -0,0 +1,40 @@
+package helper
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Value    string
+		Expected string
+	}{
+		{
+			Name:     "combining accents are composed",
+			Value:    "Gabriel Garci\u0301a Ma\u0301rquez",
+			Expected: "Gabriel Garc\u00eda M\u00e1rquez",
+		},
+		{
+			Name:     "composed name is kept",
+			Value:    "Gabriel García Márquez",
+			Expected: "Gabriel García Márquez",
+		},
+		{
+			Name:     "whitespace is collapsed",
+			Value:    "  Haruki   Murakami\t",
+			Expected: "Haruki Murakami",
+		},
+		{
+			Name:     "non latin name is kept",
+			Value:    "村上春樹",
+			Expected: "村上春樹",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if got := NormalizeName(test.Value); got != test.Expected {
+				t.Errorf("expected %q but got %q", test.Expected, got)
+			}
+		})
+	}
+}
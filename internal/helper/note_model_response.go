@@ -0,0 +1,42 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToNoteResponse(note domain.Note) web.NoteResponse {
+	return web.NoteResponse{
+		Id:        note.Id,
+		BookId:    note.BookId,
+		Type:      note.Type,
+		Text:      note.Text,
+		Page:      note.Page,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+	}
+}
+
+func ToNotesResponse(notes []domain.Note) []web.NoteResponse {
+	noteResponses := []web.NoteResponse{}
+	for _, note := range notes {
+		noteResponses = append(noteResponses, ToNoteResponse(note))
+	}
+	return noteResponses
+}
+
+func ToNoteSearchResultsResponse(results []domain.NoteSearchResult) []web.NoteSearchResultResponse {
+	resultResponses := []web.NoteSearchResultResponse{}
+	for _, result := range results {
+		resultResponses = append(resultResponses, web.NoteSearchResultResponse{
+			Id:       result.Id,
+			BookId:   result.BookId,
+			BookName: result.BookName,
+			Type:     result.Type,
+			Page:     result.Page,
+			Snippet:  HighlightSnippet(result.Snippet),
+			Rank:     result.Rank,
+		})
+	}
+	return resultResponses
+}
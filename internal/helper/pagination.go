@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+)
+
+// ToPage converts 1-based page and page_size query params into a repository page,
+// zero values fall back to the defaults
+func ToPage(page, pageSize int, sort, defaultSort string) domain.Page {
+	if page == 0 {
+		page = DefaultPage
+	}
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	if sort == "" {
+		sort = defaultSort
+	}
+
+	return domain.Page{
+		Sort:   sort,
+		Limit:  pageSize,
+		Offset: (page - 1) * pageSize,
+	}
+}
+
+// ToPaginationMeta builds the meta object returned next to paginated data
+func ToPaginationMeta(page domain.Page, total int) web.PaginationMeta {
+	currentPage := page.Offset/page.Limit + 1
+	totalPages := (total + page.Limit - 1) / page.Limit
+
+	var nextPage *int
+	if currentPage < totalPages {
+		next := currentPage + 1
+		nextPage = &next
+	}
+
+	return web.PaginationMeta{
+		Page:       currentPage,
+		PageSize:   page.Limit,
+		Total:      total,
+		TotalPages: totalPages,
+		NextPage:   nextPage,
+	}
+}
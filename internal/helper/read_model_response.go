@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToReadResponse(read domain.Read) web.ReadResponse {
+	return web.ReadResponse{
+		Id:           read.Id,
+		BookId:       read.BookId,
+		StartedDate:  read.StartedDate,
+		FinishedDate: read.FinishedDate,
+		Outcome:      read.Outcome,
+		Rating:       read.Rating,
+		CreatedAt:    read.CreatedAt,
+		UpdatedAt:    read.UpdatedAt,
+	}
+}
+
+func ToReadsResponse(reads []domain.Read) []web.ReadResponse {
+	readResponses := []web.ReadResponse{}
+	for _, read := range reads {
+		readResponses = append(readResponses, ToReadResponse(read))
+	}
+	return readResponses
+}
+
+func ToChangeReadResponse(read domain.Read, book domain.Book) web.ChangeReadResponse {
+	return web.ChangeReadResponse{
+		Read:          ToReadResponse(read),
+		CurrentPage:   book.CurrentPage,
+		Status:        book.Status,
+		CompletedDate: book.CompletedDate,
+	}
+}
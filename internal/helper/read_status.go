@@ -0,0 +1,25 @@
+package helper
+
+import "github.com/mhaatha/go-bookshelf/internal/model/domain"
+
+// BookStatusFromReads derives the status and the completed_date of a book from its latest read, reads are
+// ordered the latest to finish first. A read in progress means reading wherever it is, a finished read completes
+// the book on its finished_date and an abandoned read puts the book back on plan_to_read. ok is false when
+// there is no read
+func BookStatusFromReads(reads []domain.Read) (status string, completedDate string, ok bool) {
+	if len(reads) == 0 {
+		return "", "", false
+	}
+
+	for _, read := range reads {
+		if read.Outcome == "" {
+			return "reading", "", true
+		}
+	}
+
+	if latest := reads[0]; latest.Outcome == domain.ReadOutcomeFinished {
+		return "completed", latest.FinishedDate, true
+	}
+
+	return "plan_to_read", "", true
+}
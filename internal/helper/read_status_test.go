@@ -0,0 +1,61 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func TestBookStatusFromReads(t *testing.T) {
+	finished := domain.Read{StartedDate: "2024-01-03", FinishedDate: "2024-02-10", Outcome: domain.ReadOutcomeFinished}
+	abandoned := domain.Read{StartedDate: "2025-06-01", FinishedDate: "2025-06-20", Outcome: domain.ReadOutcomeAbandoned}
+	inProgress := domain.Read{StartedDate: "2026-03-01"}
+	backdated := domain.Read{StartedDate: "2023-11-20"}
+
+	cases := []struct {
+		Name          string
+		Reads         []domain.Read
+		Status        string
+		CompletedDate string
+		Ok            bool
+	}{
+		{
+			Name: "no read",
+			Ok:   false,
+		},
+		{
+			Name:          "finished read",
+			Reads:         []domain.Read{finished},
+			Status:        "completed",
+			CompletedDate: "2024-02-10",
+			Ok:            true,
+		},
+		{
+			Name:   "re-read in progress",
+			Reads:  []domain.Read{inProgress, finished},
+			Status: "reading",
+			Ok:     true,
+		},
+		{
+			Name:   "backdated read in progress after a finished read",
+			Reads:  []domain.Read{finished, backdated},
+			Status: "reading",
+			Ok:     true,
+		},
+		{
+			Name:   "abandoned re-read",
+			Reads:  []domain.Read{abandoned, finished},
+			Status: "plan_to_read",
+			Ok:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			status, completedDate, ok := BookStatusFromReads(c.Reads)
+			if status != c.Status || completedDate != c.CompletedDate || ok != c.Ok {
+				t.Errorf("expected %s, '%s', %v but got %s, '%s', %v", c.Status, c.CompletedDate, c.Ok, status, completedDate, ok)
+			}
+		})
+	}
+}
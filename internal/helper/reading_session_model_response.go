@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToReadingSessionResponse(session domain.ReadingSession) web.ReadingSessionResponse {
+	return web.ReadingSessionResponse{
+		Id:          session.Id,
+		BookId:      session.BookId,
+		SessionDate: session.SessionDate,
+		StartPage:   session.StartPage,
+		EndPage:     session.EndPage,
+		MinutesRead: session.MinutesRead,
+		CreatedAt:   session.CreatedAt,
+		UpdatedAt:   session.UpdatedAt,
+	}
+}
+
+func ToReadingSessionsResponse(sessions []domain.ReadingSession) []web.ReadingSessionResponse {
+	var sessionResponses []web.ReadingSessionResponse
+	for _, session := range sessions {
+		sessionResponses = append(sessionResponses, ToReadingSessionResponse(session))
+	}
+	return sessionResponses
+}
+
+func ToCreateReadingSessionResponse(session domain.ReadingSession, book domain.Book) web.CreateReadingSessionResponse {
+	return web.CreateReadingSessionResponse{
+		Session:       ToReadingSessionResponse(session),
+		CurrentPage:   book.CurrentPage,
+		Status:        book.Status,
+		CompletedDate: book.CompletedDate,
+	}
+}
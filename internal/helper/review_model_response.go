@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToReviewResponse(review domain.Review) web.ReviewResponse {
+	return web.ReviewResponse{
+		Id:        review.Id,
+		BookId:    review.BookId,
+		Rating:    review.Rating,
+		Body:      review.Body,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+func ToReviewRevisionResponses(revisions []domain.ReviewRevision) []web.ReviewRevisionResponse {
+	revisionResponses := []web.ReviewRevisionResponse{}
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, web.ReviewRevisionResponse{
+			Id:        revision.Id,
+			Rating:    revision.Rating,
+			Body:      revision.Body,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+	return revisionResponses
+}
+
+func ToAuthorRatingResponses(ratings []domain.AuthorRating) []web.AuthorRatingResponse {
+	ratingResponses := []web.AuthorRatingResponse{}
+	for _, rating := range ratings {
+		distribution := []web.RatingBucketResponse{}
+		for _, bucket := range rating.Distribution {
+			distribution = append(distribution, web.RatingBucketResponse{
+				Rating: bucket.Rating,
+				Count:  bucket.Count,
+			})
+		}
+
+		ratingResponses = append(ratingResponses, web.AuthorRatingResponse{
+			AuthorId:     rating.AuthorId,
+			FullName:     rating.FullName,
+			Average:      rating.Average,
+			Count:        rating.Count,
+			Distribution: distribution,
+		})
+	}
+	return ratingResponses
+}
@@ -0,0 +1,24 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToSearchResultResponse(result domain.SearchResult) web.SearchResultResponse {
+	return web.SearchResultResponse{
+		Type:    result.Type,
+		Id:      result.Id,
+		Title:   result.Title,
+		Snippet: HighlightSnippet(result.Snippet),
+		Rank:    result.Rank,
+	}
+}
+
+func ToSearchResultsResponse(results []domain.SearchResult) []web.SearchResultResponse {
+	resultResponses := []web.SearchResultResponse{}
+	for _, result := range results {
+		resultResponses = append(resultResponses, ToSearchResultResponse(result))
+	}
+	return resultResponses
+}
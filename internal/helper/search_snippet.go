@@ -0,0 +1,21 @@
+package helper
+
+import (
+	"html"
+	"strings"
+)
+
+// ts_headline marks a match with these private use characters instead of <mark>, the text around a match is
+// a book name or an author name as the user typed it, so it is escaped before the markers become <mark>.
+// The queries select them with chr(57344) and chr(57345)
+const (
+	SnippetStartSel = "\uE000"
+	SnippetStopSel  = "\uE001"
+)
+
+var snippetMarker = strings.NewReplacer(SnippetStartSel, "<mark>", SnippetStopSel, "</mark>")
+
+// HighlightSnippet escapes a ts_headline snippet for HTML and wraps the matches in <mark>
+func HighlightSnippet(snippet string) string {
+	return snippetMarker.Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,35 @@
+package helper
+
+import "testing"
+
+func TestHighlightSnippet(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Snippet  string
+		Expected string
+	}{
+		{
+			Name:     "match is marked",
+			Snippet:  "The " + SnippetStartSel + "Hobbit" + SnippetStopSel,
+			Expected: "The <mark>Hobbit</mark>",
+		},
+		{
+			Name:     "markup in the text is escaped",
+			Snippet:  SnippetStartSel + "Dune" + SnippetStopSel + " <img src=x onerror=alert(1)>",
+			Expected: "<mark>Dune</mark> &lt;img src=x onerror=alert(1)&gt;",
+		},
+		{
+			Name:     "mark typed in the text is not a match",
+			Snippet:  "<mark>Dune</mark> & Children",
+			Expected: "&lt;mark&gt;Dune&lt;/mark&gt; &amp; Children",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if got := HighlightSnippet(test.Snippet); got != test.Expected {
+				t.Errorf("expected %q but got %q", test.Expected, got)
+			}
+		})
+	}
+}
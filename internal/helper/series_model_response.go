@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToCreateSeriesResponse(series domain.Series) web.CreateSeriesResponse {
+	return web.CreateSeriesResponse{
+		Id:        series.Id,
+		Name:      series.Name,
+		AuthorId:  series.AuthorId,
+		CreatedAt: series.CreatedAt,
+		UpdatedAt: series.UpdatedAt,
+	}
+}
+
+func ToGetSeriesResponse(series domain.Series) web.GetSeriesResponse {
+	return web.GetSeriesResponse{
+		Id:        series.Id,
+		Name:      series.Name,
+		AuthorId:  series.AuthorId,
+		CreatedAt: series.CreatedAt,
+		UpdatedAt: series.UpdatedAt,
+	}
+}
+
+func ToGetSeriesListResponse(seriesList []domain.Series) []web.GetSeriesResponse {
+	var seriesResponses []web.GetSeriesResponse
+	for _, series := range seriesList {
+		seriesResponses = append(seriesResponses, ToGetSeriesResponse(series))
+	}
+	return seriesResponses
+}
+
+func ToUpdateSeriesResponse(series domain.Series) web.UpdateSeriesResponse {
+	return web.UpdateSeriesResponse{
+		Id:        series.Id,
+		Name:      series.Name,
+		AuthorId:  series.AuthorId,
+		CreatedAt: series.CreatedAt,
+		UpdatedAt: series.UpdatedAt,
+	}
+}
+
+func ToSeriesVolumeResponse(book domain.Book) web.SeriesVolumeResponse {
+	return web.SeriesVolumeResponse{
+		Id:             book.Id,
+		Name:           book.Name,
+		SeriesPosition: book.SeriesPosition,
+		Status:         book.Status,
+		CurrentPage:    book.CurrentPage,
+		TotalPage:      book.TotalPage,
+		CompletedDate:  book.CompletedDate,
+	}
+}
+
+func ToSeriesVolumeResponses(books []domain.Book) []web.SeriesVolumeResponse {
+	volumeResponses := []web.SeriesVolumeResponse{}
+	for _, book := range books {
+		volumeResponses = append(volumeResponses, ToSeriesVolumeResponse(book))
+	}
+	return volumeResponses
+}
@@ -0,0 +1,169 @@
+package helper
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+var ErrUnknownCSVFormat = errors.New("file is not a Goodreads or StoryGraph CSV export")
+
+// shelfCSVColumns lists the header names of each export, the first matching format wins
+var shelfCSVColumns = []struct {
+	format    string
+	title     string
+	author    string
+	totalPage string
+	shelf     string
+	dateRead  string
+	dateAdded string
+}{
+	{
+		format:    domain.ImportFormatGoodreads,
+		title:     "Title",
+		author:    "Author",
+		totalPage: "Number of Pages",
+		shelf:     "Exclusive Shelf",
+		dateRead:  "Date Read",
+		dateAdded: "Date Added",
+	},
+	{
+		format:    domain.ImportFormatStoryGraph,
+		title:     "Title",
+		author:    "Authors",
+		shelf:     "Read Status",
+		dateRead:  "Last Date Read",
+		dateAdded: "Date Added",
+	},
+}
+
+// ParseShelfCSV detects the export format from the header and reads every row,
+// StoryGraph exports have no page count so TotalPage is left as 0
+func ParseShelfCSV(r io.Reader) (string, []domain.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return "", nil, ErrUnknownCSVFormat
+		}
+		return "", nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		// Excel adds a BOM to the first column
+		column = strings.TrimPrefix(column, "\ufeff")
+		index[strings.TrimSpace(column)] = i
+	}
+
+	for _, columns := range shelfCSVColumns {
+		if !hasColumns(index, columns.title, columns.author, columns.shelf) {
+			continue
+		}
+
+		rows := []domain.ImportRow{}
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return "", nil, err
+			}
+
+			line, _ := reader.FieldPos(0)
+
+			row := domain.ImportRow{
+				Line:       line,
+				Title:      normalizeSpaces(field(record, index, columns.title)),
+				AuthorName: firstAuthor(field(record, index, columns.author)),
+				Shelf:      strings.ToLower(strings.TrimSpace(field(record, index, columns.shelf))),
+				DateRead:   normalizeDate(field(record, index, columns.dateRead)),
+				DateAdded:  normalizeDate(field(record, index, columns.dateAdded)),
+			}
+
+			if columns.totalPage != "" {
+				row.TotalPage, _ = strconv.Atoi(strings.TrimSpace(field(record, index, columns.totalPage)))
+			}
+
+			// Skip blank lines at the end of the file
+			if row.Title == "" && row.AuthorName == "" {
+				continue
+			}
+
+			rows = append(rows, row)
+		}
+
+		return columns.format, rows, nil
+	}
+
+	return "", nil, ErrUnknownCSVFormat
+}
+
+// ShelfToStatus maps Goodreads and StoryGraph shelves to a book status
+func ShelfToStatus(shelf string) (string, error) {
+	switch shelf {
+	case "read":
+		return "completed", nil
+	case "currently-reading":
+		return "reading", nil
+	case "to-read":
+		return "plan_to_read", nil
+	default:
+		return "", fmt.Errorf("shelf '%s' can not be mapped to a status", shelf)
+	}
+}
+
+func hasColumns(index map[string]int, columns ...string) bool {
+	for _, column := range columns {
+		if _, ok := index[column]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func field(record []string, index map[string]int, column string) string {
+	i, ok := index[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return record[i]
+}
+
+func normalizeSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// firstAuthor keeps the main author of a comma separated StoryGraph author list
+func firstAuthor(value string) string {
+	author, _, _ := strings.Cut(value, ",")
+	return NormalizeName(author)
+}
+
+// normalizeDate turns the 2006/01/02 exports use into 2006-01-02, unknown formats are kept as is so validation reports them
+func normalizeDate(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	for _, layout := range []string{"2006/01/02", "2006-01-02", "2006/1/2"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.Format("2006-01-02")
+		}
+	}
+
+	return value
+}
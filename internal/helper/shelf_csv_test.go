@@ -0,0 +1,107 @@
+package helper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func TestParseShelfCSV(t *testing.T) {
+	t.Run("parse goodreads export", func(t *testing.T) {
+		csv := "\ufeffBook Id,Title,Author,Author l-f,Number of Pages,Date Read,Exclusive Shelf\n" +
+			"1,\"The Way of Kings (The Stormlight Archive, #1)\",Brandon  Sanderson,\"Sanderson, Brandon\",1007,2024/02/10,read\n" +
+			"2,Dune,Frank Herbert,\"Herbert, Frank\",,,to-read\n"
+
+		format, rows, err := ParseShelfCSV(strings.NewReader(csv))
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if format != domain.ImportFormatGoodreads {
+			t.Errorf("expected format %s but got %s", domain.ImportFormatGoodreads, format)
+		}
+
+		expectedRows := []domain.ImportRow{
+			{
+				Line:       2,
+				Title:      "The Way of Kings (The Stormlight Archive, #1)",
+				AuthorName: "Brandon Sanderson",
+				TotalPage:  1007,
+				Shelf:      "read",
+				DateRead:   "2024-02-10",
+			},
+			{
+				Line:       3,
+				Title:      "Dune",
+				AuthorName: "Frank Herbert",
+				Shelf:      "to-read",
+			},
+		}
+		if !reflect.DeepEqual(rows, expectedRows) {
+			t.Errorf("expected %+v but got %+v", expectedRows, rows)
+		}
+	})
+
+	t.Run("parse storygraph export", func(t *testing.T) {
+		csv := "Title,Authors,Contributors,ISBN/UID,Format,Read Status,Date Added,Last Date Read\n" +
+			"Good Omens,\"Terry Pratchett, Neil Gaiman\",,9780060853983,paperback,currently-reading,2024/03/01,\n"
+
+		format, rows, err := ParseShelfCSV(strings.NewReader(csv))
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if format != domain.ImportFormatStoryGraph {
+			t.Errorf("expected format %s but got %s", domain.ImportFormatStoryGraph, format)
+		}
+
+		if len(rows) != 1 {
+			t.Fatalf("expected 1 row but got %d", len(rows))
+		}
+
+		if rows[0].AuthorName != "Terry Pratchett" {
+			t.Errorf("expected first author Terry Pratchett but got %s", rows[0].AuthorName)
+		}
+
+		if rows[0].TotalPage != 0 {
+			t.Errorf("expected total page 0 but got %d", rows[0].TotalPage)
+		}
+
+		if rows[0].DateAdded != "2024-03-01" || rows[0].DateRead != "" {
+			t.Errorf("expected date added 2024-03-01 and no date read but got %s and %s", rows[0].DateAdded, rows[0].DateRead)
+		}
+	})
+
+	t.Run("parse unknown csv", func(t *testing.T) {
+		_, _, err := ParseShelfCSV(strings.NewReader("name,pages\nDune,412\n"))
+		if !errors.Is(err, ErrUnknownCSVFormat) {
+			t.Errorf("expected %v but got %v", ErrUnknownCSVFormat, err)
+		}
+	})
+}
+
+func TestShelfToStatus(t *testing.T) {
+	cases := map[string]string{
+		"read":              "completed",
+		"currently-reading": "reading",
+		"to-read":           "plan_to_read",
+	}
+
+	for shelf, expected := range cases {
+		status, err := ShelfToStatus(shelf)
+		if err != nil {
+			t.Errorf("expected no error for %s but got %v", shelf, err)
+		}
+
+		if status != expected {
+			t.Errorf("expected %s for %s but got %s", expected, shelf, status)
+		}
+	}
+
+	if _, err := ShelfToStatus("did-not-finish"); err == nil {
+		t.Error("expected an error for did-not-finish but got nil")
+	}
+}
@@ -0,0 +1,60 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToStatsResponse(stats domain.Stats, from, to time.Time, groupBy string) web.StatsResponse {
+	statsResponse := web.StatsResponse{
+		From:    from.Format(dateLayout),
+		To:      to.Format(dateLayout),
+		GroupBy: groupBy,
+		Totals: web.StatsTotalsResponse{
+			BooksCompleted:    stats.Totals.Books,
+			Rereads:           stats.Totals.Rereads,
+			PagesRead:         stats.Totals.Pages,
+			AverageBookLength: stats.Totals.AverageBookLength,
+		},
+		Timeline:      []web.StatsBucketResponse{},
+		Status:        []web.StatusCountResponse{},
+		TopAuthors:    []web.AuthorStatsResponse{},
+		Nationalities: []web.NationalityStatsResponse{},
+	}
+
+	for _, bucket := range stats.Timeline {
+		statsResponse.Timeline = append(statsResponse.Timeline, web.StatsBucketResponse{
+			PeriodStart:    bucket.PeriodStart.Format(dateLayout),
+			BooksCompleted: bucket.BooksCompleted,
+			PagesRead:      bucket.PagesRead,
+		})
+	}
+
+	for _, count := range stats.Status {
+		statsResponse.Status = append(statsResponse.Status, web.StatusCountResponse{
+			Status: count.Status,
+			Count:  count.Count,
+		})
+	}
+
+	for _, author := range stats.TopAuthors {
+		statsResponse.TopAuthors = append(statsResponse.TopAuthors, web.AuthorStatsResponse{
+			AuthorId:       author.AuthorId,
+			FullName:       author.FullName,
+			BooksCompleted: author.BooksCompleted,
+			Pages:          author.Pages,
+		})
+	}
+
+	for _, nationality := range stats.Nationalities {
+		statsResponse.Nationalities = append(statsResponse.Nationalities, web.NationalityStatsResponse{
+			Nationality:    nationality.Nationality,
+			BooksCompleted: nationality.BooksCompleted,
+			Authors:        nationality.Authors,
+		})
+	}
+
+	return statsResponse
+}
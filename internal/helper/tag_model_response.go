@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToCreateTagResponse(tag domain.Tag) web.CreateTagResponse {
+	return web.CreateTagResponse{
+		Id:        tag.Id,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func ToGetTagResponse(tag domain.Tag) web.GetTagResponse {
+	return web.GetTagResponse{
+		Id:        tag.Id,
+		Name:      tag.Name,
+		BookCount: tag.BookCount,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func ToGetTagsResponse(tags []domain.Tag) []web.GetTagResponse {
+	var tagResponses []web.GetTagResponse
+	for _, tag := range tags {
+		tagResponses = append(tagResponses, ToGetTagResponse(tag))
+	}
+	return tagResponses
+}
+
+func ToUpdateTagResponse(tag domain.Tag) web.UpdateTagResponse {
+	return web.UpdateTagResponse{
+		Id:        tag.Id,
+		Name:      tag.Name,
+		BookCount: tag.BookCount,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func ToBookTagResponses(tags []domain.Tag) []web.BookTagResponse {
+	tagResponses := []web.BookTagResponse{}
+	for _, tag := range tags {
+		tagResponses = append(tagResponses, web.BookTagResponse{
+			Id:   tag.Id,
+			Name: tag.Name,
+		})
+	}
+	return tagResponses
+}
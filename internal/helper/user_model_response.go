@@ -0,0 +1,22 @@
+package helper
+
+import (
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func ToCreateUserResponse(user domain.User) web.CreateUserResponse {
+	return web.CreateUserResponse{
+		FullName:  user.FullName,
+		Email:     user.Email,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}
+}
+
+func ToLoginResponse(user domain.User) web.LoginResponse {
+	return web.LoginResponse{
+		Id:       user.Id,
+		FullName: user.FullName,
+	}
+}
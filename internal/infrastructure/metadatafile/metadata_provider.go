@@ -0,0 +1,48 @@
+package metadatafile
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+// metadataProvider implements service.MetadataProvider from a JSON file keyed by ISBN,
+// it is used by tests and local development so nothing depends on the network
+type metadataProvider struct {
+	books map[string]domain.BookMetadata
+}
+
+// NewMetadataProvider loads a file shaped like {"9780441172719": {"title": "Dune", ...}}
+func NewMetadataProvider(path string) (service.MetadataProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]domain.BookMetadata{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+
+	books := make(map[string]domain.BookMetadata, len(raw))
+	for isbn, metadata := range raw {
+		isbn = helper.NormalizeISBN(isbn)
+		metadata.Isbn = isbn
+		books[isbn] = metadata
+	}
+
+	return &metadataProvider{books: books}, nil
+}
+
+func (p *metadataProvider) LookupByISBN(ctx context.Context, isbn string) (domain.BookMetadata, error) {
+	metadata, ok := p.books[helper.NormalizeISBN(isbn)]
+	if !ok {
+		return domain.BookMetadata{}, service.ErrMetadataNotFound
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,34 @@
+package metadatafile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+func TestLookupByISBN(t *testing.T) {
+	provider, err := NewMetadataProvider("testdata/metadata.json")
+	if err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	t.Run("lookup isbn regardless of hyphens", func(t *testing.T) {
+		metadata, err := provider.LookupByISBN(context.Background(), "978-0441172719")
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if metadata.Title != "Dune" || metadata.Isbn != "9780441172719" {
+			t.Errorf("expected Dune with isbn 9780441172719 but got %+v", metadata)
+		}
+	})
+
+	t.Run("lookup unknown isbn", func(t *testing.T) {
+		_, err := provider.LookupByISBN(context.Background(), "0306406152")
+		if !errors.Is(err, service.ErrMetadataNotFound) {
+			t.Errorf("expected %v but got %v", service.ErrMetadataNotFound, err)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package openlibrary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+	"golang.org/x/text/language"
+)
+
+// maxAuthors limits the author requests made for a single lookup
+const maxAuthors = 5
+
+var yearRegex = regexp.MustCompile(`\b(\d{4})\b`)
+
+type reference struct {
+	Key string `json:"key"`
+}
+
+// edition is the subset of https://openlibrary.org/isbn/{isbn}.json that is used
+type edition struct {
+	Title         string      `json:"title"`
+	Subtitle      string      `json:"subtitle"`
+	Publishers    []string    `json:"publishers"`
+	PublishDate   string      `json:"publish_date"`
+	NumberOfPages int         `json:"number_of_pages"`
+	Languages     []reference `json:"languages"`
+	Authors       []reference `json:"authors"`
+	Works         []reference `json:"works"`
+}
+
+type work struct {
+	Authors []struct {
+		Author reference `json:"author"`
+	} `json:"authors"`
+}
+
+type author struct {
+	Name string `json:"name"`
+}
+
+// metadataProvider implements service.MetadataProvider with the Open Library JSON API
+type metadataProvider struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewMetadataProvider(client *http.Client, baseURL string) service.MetadataProvider {
+	return &metadataProvider{
+		client:  client,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (p *metadataProvider) LookupByISBN(ctx context.Context, isbn string) (domain.BookMetadata, error) {
+	isbn = helper.NormalizeISBN(isbn)
+
+	var e edition
+	if err := p.get(ctx, "/isbn/"+isbn+".json", &e); err != nil {
+		return domain.BookMetadata{}, err
+	}
+
+	metadata := domain.BookMetadata{
+		Isbn:      isbn,
+		Title:     e.Title,
+		TotalPage: e.NumberOfPages,
+		Authors:   []string{},
+	}
+
+	if e.Subtitle != "" {
+		metadata.Title = e.Title + ": " + e.Subtitle
+	}
+
+	if len(e.Publishers) > 0 {
+		metadata.Publisher = e.Publishers[0]
+	}
+
+	if match := yearRegex.FindStringSubmatch(e.PublishDate); match != nil {
+		fmt.Sscan(match[1], &metadata.PublicationYear)
+	}
+
+	// Languages are MARC codes such as /languages/eng, they are stored as BCP 47 tags such as en
+	if len(e.Languages) > 0 {
+		code := strings.TrimPrefix(e.Languages[0].Key, "/languages/")
+		if tag, err := language.Parse(code); err == nil {
+			metadata.Language = tag.String()
+		}
+	}
+
+	// Some editions only list their authors on the work
+	authorKeys := []string{}
+	for _, a := range e.Authors {
+		authorKeys = append(authorKeys, a.Key)
+	}
+	if len(authorKeys) == 0 && len(e.Works) > 0 {
+		var w work
+		if err := p.get(ctx, e.Works[0].Key+".json", &w); err != nil {
+			return domain.BookMetadata{}, err
+		}
+
+		for _, a := range w.Authors {
+			authorKeys = append(authorKeys, a.Author.Key)
+		}
+	}
+
+	for i, key := range authorKeys {
+		if i == maxAuthors {
+			break
+		}
+
+		var a author
+		if err := p.get(ctx, key+".json", &a); err != nil {
+			return domain.BookMetadata{}, err
+		}
+
+		if a.Name != "" {
+			metadata.Authors = append(metadata.Authors, a.Name)
+		}
+	}
+
+	return metadata, nil
+}
+
+func (p *metadataProvider) get(ctx context.Context, path string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return service.ErrMetadataNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("open library returned status %d for %s", res.StatusCode, path)
+	}
+
+	return json.NewDecoder(res.Body).Decode(result)
+}
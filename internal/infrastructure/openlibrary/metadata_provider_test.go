@@ -0,0 +1,69 @@
+package openlibrary
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/service"
+)
+
+// newFakeOpenLibrary serves the recorded responses in testdata so the test runs offline
+func newFakeOpenLibrary(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	routes := map[string]string{
+		"/isbn/9780441172719.json": "testdata/isbn_9780441172719.json",
+		"/works/OL893415W.json":    "testdata/works_OL893415W.json",
+		"/authors/OL79034A.json":   "testdata/authors_OL79034A.json",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.ServeFile(w, r, file)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestLookupByISBN(t *testing.T) {
+	server := newFakeOpenLibrary(t)
+	provider := NewMetadataProvider(server.Client(), server.URL)
+
+	t.Run("lookup edition with authors on the work", func(t *testing.T) {
+		metadata, err := provider.LookupByISBN(context.Background(), "978-0-441-17271-9")
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expected := domain.BookMetadata{
+			Isbn:            "9780441172719",
+			Title:           "Dune: Deluxe Edition",
+			Authors:         []string{"Frank Herbert"},
+			Publisher:       "Ace Books",
+			PublicationYear: 1990,
+			Language:        "en",
+			TotalPage:       535,
+		}
+		if !reflect.DeepEqual(metadata, expected) {
+			t.Errorf("expected %+v but got %+v", expected, metadata)
+		}
+	})
+
+	t.Run("lookup unknown isbn", func(t *testing.T) {
+		_, err := provider.LookupByISBN(context.Background(), "0306406152")
+		if !errors.Is(err, service.ErrMetadataNotFound) {
+			t.Errorf("expected %v but got %v", service.ErrMetadataNotFound, err)
+		}
+	})
+}
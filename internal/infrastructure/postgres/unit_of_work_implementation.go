@@ -24,7 +24,7 @@ func (t *pgxTransaction) Rollback(ctx context.Context) error {
 	return t.tx.Rollback(ctx)
 }
 
-// pgxTransaction creates new GetAuthorRepository and GetBookRepository instance.
+// pgxTransaction creates new repository instances that share the same db tx.
 // This can be done since pgx.Tx implemented repository.DBTX
 func (t *pgxTransaction) GetAuthorRepository() repository.AuthorRepository {
 	return repository.NewAuthorRepository(t.tx)
@@ -34,6 +34,58 @@ func (t *pgxTransaction) GetBookRepository() repository.BookRepository {
 	return repository.NewBookRepository(t.tx)
 }
 
+func (t *pgxTransaction) GetUserRepository() repository.UserRepository {
+	return repository.NewUserRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetRefreshTokenRepository() repository.RefreshTokenRepository {
+	return repository.NewRefreshTokenRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetReadingSessionRepository() repository.ReadingSessionRepository {
+	return repository.NewReadingSessionRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetSearchRepository() repository.SearchRepository {
+	return repository.NewSearchRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetTagRepository() repository.TagRepository {
+	return repository.NewTagRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetSeriesRepository() repository.SeriesRepository {
+	return repository.NewSeriesRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetReviewRepository() repository.ReviewRepository {
+	return repository.NewReviewRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetNoteRepository() repository.NoteRepository {
+	return repository.NewNoteRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetHighlightRepository() repository.HighlightRepository {
+	return repository.NewHighlightRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetGoalRepository() repository.GoalRepository {
+	return repository.NewGoalRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetStatsRepository() repository.StatsRepository {
+	return repository.NewStatsRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetReadRepository() repository.ReadRepository {
+	return repository.NewReadRepository(t.tx)
+}
+
+func (t *pgxTransaction) GetAuthorAliasRepository() repository.AuthorAliasRepository {
+	return repository.NewAuthorAliasRepository(t.tx)
+}
+
 // pgxUnitOfWork implements UnitOfWork.
 // pgxUnitOfWork is literally a db pool, it holds pgxpool.Pool value inside
 // that's why pgxUnitOfWork will be passed in to service parameter.
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+const (
+	protectedPrefix = "/api/v1/"
+	bearerPrefix    = "Bearer "
+)
+
+// publicPaths can be accessed without an access token
+var publicPaths = map[string]bool{
+	"/api/v1/auth/register": true,
+	"/api/v1/auth/login":    true,
+	"/api/v1/auth/refresh":  true,
+}
+
+// AuthMiddleware rejects /api/v1 requests without a valid access token
+// and stores the authenticated user id in the request context
+func AuthMiddleware(next http.Handler, cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, protectedPrefix) || publicPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, bearerPrefix) {
+			slog.Error("missing access token", "method", r.Method, "endpoint", r.URL)
+
+			helper.WriteToResponseBody(w, http.StatusUnauthorized, web.WebFailedResponse{
+				Errors: "Missing access token",
+			})
+			return
+		}
+
+		userId, err := helper.ParseAccessToken(strings.TrimPrefix(authorization, bearerPrefix), cfg.AccessTokenSecret)
+		if err != nil {
+			slog.Error("invalid access token", "method", r.Method, "endpoint", r.URL, "err", err)
+
+			helper.WriteToResponseBody(w, http.StatusUnauthorized, web.WebFailedResponse{
+				Errors: "Invalid or expired access token",
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(helper.WithUserId(r.Context(), userId)))
+	})
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+const (
+	testSecret = "test-secret"
+	testUserId = "c512ae16-5f33-4a3c-a1e1-977bd5a20af3"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	cfg := &config.Config{AccessTokenSecret: testSecret}
+
+	// next records the user id that reached the wrapped handler
+	var calledWithUserId string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calledWithUserId, _ = helper.UserIdFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	validToken, err := helper.GenerateAccessToken(testUserId, testSecret, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("error when generating access token: %v", err)
+	}
+
+	expiredToken, err := helper.GenerateAccessToken(testUserId, testSecret, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("error when generating access token: %v", err)
+	}
+
+	otherSecretToken, err := helper.GenerateAccessToken(testUserId, "other-secret", time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("error when generating access token: %v", err)
+	}
+
+	t.Run("request with valid access token", func(t *testing.T) {
+		calledWithUserId = ""
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/books", nil)
+		req.Header.Set("Authorization", "Bearer "+validToken)
+		res := httptest.NewRecorder()
+
+		AuthMiddleware(next, cfg).ServeHTTP(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+
+		// Check user id that has been placed in context
+		if calledWithUserId != testUserId {
+			t.Errorf("expected user id '%s' but got '%s'", testUserId, calledWithUserId)
+		}
+	})
+
+	t.Run("request to public path without access token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+		res := httptest.NewRecorder()
+
+		AuthMiddleware(next, cfg).ServeHTTP(res, req)
+
+		// Check status code
+		if res.Code != http.StatusOK {
+			t.Errorf("expected status code of %d but got %d", http.StatusOK, res.Code)
+		}
+	})
+
+	t.Run("request with invalid access token", func(t *testing.T) {
+		cases := []struct {
+			Name          string
+			Authorization string
+			ErrMessage    string
+		}{
+			{
+				Name:          "missing",
+				Authorization: "",
+				ErrMessage:    "Missing access token",
+			},
+			{
+				Name:          "not bearer",
+				Authorization: "Basic dXNlcjpwYXNz",
+				ErrMessage:    "Missing access token",
+			},
+			{
+				Name:          "expired",
+				Authorization: "Bearer " + expiredToken,
+				ErrMessage:    "Invalid or expired access token",
+			},
+			{
+				Name:          "wrong signature",
+				Authorization: "Bearer " + otherSecretToken,
+				ErrMessage:    "Invalid or expired access token",
+			},
+			{
+				Name:          "malformed",
+				Authorization: "Bearer not-a-token",
+				ErrMessage:    "Invalid or expired access token",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.Name, func(t *testing.T) {
+				calledWithUserId = ""
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/books", nil)
+				if c.Authorization != "" {
+					req.Header.Set("Authorization", c.Authorization)
+				}
+				res := httptest.NewRecorder()
+
+				AuthMiddleware(next, cfg).ServeHTTP(res, req)
+
+				// Check status code
+				if res.Code != http.StatusUnauthorized {
+					t.Errorf("expected status code of %d but got %d", http.StatusUnauthorized, res.Code)
+				}
+
+				// Get the actual response
+				var actualResponseBody web.WebFailedResponse
+				err := json.NewDecoder(res.Body).Decode(&actualResponseBody)
+				if err != nil {
+					t.Fatalf("error when parsing res body: %v", err)
+				}
+
+				if actualResponseBody.Errors != c.ErrMessage {
+					t.Errorf("expected %s but got %v", c.ErrMessage, actualResponseBody.Errors)
+				}
+
+				// The wrapped handler must not be reached
+				if calledWithUserId != "" {
+					t.Errorf("expected next handler not to be called but it was called with '%s'", calledWithUserId)
+				}
+			})
+		}
+	})
+}
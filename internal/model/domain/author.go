@@ -2,10 +2,28 @@ package domain
 
 import "time"
 
+// Ways to delete an author who still has books, restrict refuses, cascade deletes the books with the author
+const (
+	AuthorDeleteModeRestrict = "restrict"
+	AuthorDeleteModeCascade  = "cascade"
+)
+
+// AuthorPhotoKeyPrefix keeps portraits apart from book covers, the author bucket falls back to the book bucket
+const AuthorPhotoKeyPrefix = "authors/"
+
+// Author.Bio is markdown, it is stored as written and rendered by clients.
+// OpenLibraryId and WikidataId identify the author outside the catalog, such as OL23919A and Q5879
 type Author struct {
-	Id          string    `json:"id"`
-	FullName    string    `json:"full_name"`
-	Nationality string    `json:"nationality"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id            string    `json:"id"`
+	FullName      string    `json:"full_name"`
+	Nationality   string    `json:"nationality"`
+	BirthDate     string    `json:"birth_date,omitempty"`
+	DeathDate     string    `json:"death_date,omitempty"`
+	Bio           string    `json:"bio,omitempty"`
+	Website       string    `json:"website,omitempty"`
+	OpenLibraryId string    `json:"open_library_id,omitempty"`
+	WikidataId    string    `json:"wikidata_id,omitempty"`
+	PhotoKey      string    `json:"photo_key,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// AuthorAlias is another spelling of the full_name of an author
+type AuthorAlias struct {
+	Id        string    `json:"id"`
+	AuthorId  string    `json:"author_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -2,26 +2,75 @@ package domain
 
 import "time"
 
+// Book.AuthorId is the first of the book contributors, it is kept for clients that only know a single author
 type Book struct {
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
-	TotalPage     int       `json:"total_page"`
-	AuthorId      string    `json:"author_id"`
-	PhotoKey      string    `json:"photo_key,omitempty"`
-	Status        string    `json:"status"`
-	CompletedDate string    `json:"completed_date,omitempty"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	Id              string            `json:"id"`
+	OwnerId         string            `json:"owner_id"`
+	Name            string            `json:"name"`
+	TotalPage       int               `json:"total_page"`
+	CurrentPage     int               `json:"current_page"`
+	AuthorId        string            `json:"author_id"`
+	PhotoKey        string            `json:"photo_key,omitempty"`
+	Status          string            `json:"status"`
+	CompletedDate   string            `json:"completed_date,omitempty"`
+	Isbn            string            `json:"isbn,omitempty"`
+	Publisher       string            `json:"publisher,omitempty"`
+	PublicationYear int               `json:"publication_year,omitempty"`
+	Language        string            `json:"language,omitempty"`
+	SeriesId        string            `json:"series_id,omitempty"`
+	SeriesPosition  float64           `json:"series_position,omitempty"`
+	Rating          float64           `json:"rating,omitempty"`
+	Contributors    []BookContributor `json:"contributors"`
+	Tags            []Tag             `json:"tags,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
 }
 
 type BookWithURL struct {
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
-	TotalPage     int       `json:"total_page"`
-	AuthorId      string    `json:"author_id"`
-	PhotoURL      string    `json:"photo_url,omitempty"`
-	Status        string    `json:"status"`
-	CompletedDate string    `json:"completed_date,omitempty"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	Id              string            `json:"id"`
+	Name            string            `json:"name"`
+	TotalPage       int               `json:"total_page"`
+	CurrentPage     int               `json:"current_page"`
+	AuthorId        string            `json:"author_id"`
+	PhotoURL        string            `json:"photo_url,omitempty"`
+	Status          string            `json:"status"`
+	CompletedDate   string            `json:"completed_date,omitempty"`
+	Isbn            string            `json:"isbn,omitempty"`
+	Publisher       string            `json:"publisher,omitempty"`
+	PublicationYear int               `json:"publication_year,omitempty"`
+	Language        string            `json:"language,omitempty"`
+	SeriesId        string            `json:"series_id,omitempty"`
+	SeriesPosition  float64           `json:"series_position,omitempty"`
+	Rating          float64           `json:"rating,omitempty"`
+	Contributors    []BookContributor `json:"contributors"`
+	Tags            []Tag             `json:"tags,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+}
+
+// BookWithAuthor is a book joined with its author, used by the library export
+type BookWithAuthor struct {
+	Id                string    `json:"id"`
+	Name              string    `json:"name"`
+	TotalPage         int       `json:"total_page"`
+	CurrentPage       int       `json:"current_page"`
+	AuthorId          string    `json:"author_id"`
+	AuthorName        string    `json:"author_name"`
+	AuthorNationality string    `json:"author_nationality"`
+	Status            string    `json:"status"`
+	CompletedDate     string    `json:"completed_date,omitempty"`
+	Isbn              string    `json:"isbn,omitempty"`
+	Publisher         string    `json:"publisher,omitempty"`
+	PublicationYear   int       `json:"publication_year,omitempty"`
+	Language          string    `json:"language,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// BookCollision is a set of books of one owner with the same name that would share an author after a merge,
+// the first of BookIds is the one the others are merged into
+type BookCollision struct {
+	OwnerId string   `json:"owner_id"`
+	Name    string   `json:"name"`
+	BookIds []string `json:"book_ids"`
 }
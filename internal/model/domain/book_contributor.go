@@ -0,0 +1,21 @@
+package domain
+
+// Roles of a contributor, they match the contributor_role enum
+const (
+	ContributorRoleAuthor      = "author"
+	ContributorRoleCoAuthor    = "co_author"
+	ContributorRoleTranslator  = "translator"
+	ContributorRoleIllustrator = "illustrator"
+)
+
+// BookContributor links a book to one of the authors in the catalog,
+// Position orders the contributors of a book and the first one is the book's primary author.
+// AliasId is the alias of the author the book was published under, PublishedAs is its name
+type BookContributor struct {
+	AuthorId    string `json:"author_id"`
+	FullName    string `json:"full_name,omitempty"`
+	Role        string `json:"role"`
+	Position    int    `json:"position"`
+	AliasId     string `json:"alias_id,omitempty"`
+	PublishedAs string `json:"published_as,omitempty"`
+}
@@ -0,0 +1,25 @@
+package domain
+
+// Tag match modes of BookFilter
+const (
+	TagsMatchAll = "all"
+	TagsMatchAny = "any"
+)
+
+// BookFilter narrows the books returned by BookRepository.FindAll, empty fields are not applied
+type BookFilter struct {
+	Name       string
+	Status     string
+	AuthorName string
+
+	// CompletedFrom and CompletedTo bound completed_date, both are inclusive YYYY-MM-DD dates
+	CompletedFrom string
+	CompletedTo   string
+
+	// RatingMin keeps books whose current review has at least this rating, unrated books are left out
+	RatingMin float64
+
+	// Tags are matched by name regardless of case, TagsMatch tells whether a book needs all of them or any of them
+	Tags      []string
+	TagsMatch string
+}
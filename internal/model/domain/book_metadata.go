@@ -0,0 +1,12 @@
+package domain
+
+// BookMetadata is what a metadata provider knows about a single edition
+type BookMetadata struct {
+	Isbn            string   `json:"isbn"`
+	Title           string   `json:"title"`
+	Authors         []string `json:"authors"`
+	Publisher       string   `json:"publisher"`
+	PublicationYear int      `json:"publication_year"`
+	Language        string   `json:"language"`
+	TotalPage       int      `json:"total_page"`
+}
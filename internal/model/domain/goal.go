@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// Metrics and periods of a goal, they match the goal_metric and goal_period enums
+const (
+	GoalMetricBooks = "books"
+	GoalMetricPages = "pages"
+
+	GoalPeriodYear    = "year"
+	GoalPeriodQuarter = "quarter"
+	GoalPeriodMonth   = "month"
+)
+
+// Goal is a reading target for a period, EndDate is the last day of the period
+type Goal struct {
+	Id        string    `json:"id"`
+	OwnerId   string    `json:"owner_id"`
+	Metric    string    `json:"metric"`
+	Period    string    `json:"period"`
+	Target    int       `json:"target"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GoalFilter struct {
+	Metric string
+	Year   int
+}
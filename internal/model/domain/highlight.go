@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// Highlight is a highlight or note imported from an e-reader, Type is NoteTypeHighlight or NoteTypeNote.
+// Location is the e-reader location such as 170-172, Page is 0 and ClippedAt is nil when the clipping has none
+type Highlight struct {
+	Id          string     `json:"id"`
+	BookId      string     `json:"book_id"`
+	Type        string     `json:"type"`
+	Text        string     `json:"text"`
+	Location    string     `json:"location,omitempty"`
+	Page        int        `json:"page,omitempty"`
+	ClippedAt   *time.Time `json:"clipped_at,omitempty"`
+	Fingerprint string     `json:"fingerprint"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
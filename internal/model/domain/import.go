@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+const (
+	ImportFormatGoodreads  = "goodreads"
+	ImportFormatStoryGraph = "storygraph"
+	ImportFormatKindle     = "kindle"
+	ImportFormatKobo       = "kobo"
+)
+
+// Kinds of an e-reader clipping, bookmarks carry no text and are not imported
+const (
+	ClippingKindHighlight = "highlight"
+	ClippingKindNote      = "note"
+	ClippingKindBookmark  = "bookmark"
+)
+
+// ImportRow is a single book read from a Goodreads or StoryGraph CSV export
+type ImportRow struct {
+	Line       int
+	Title      string
+	AuthorName string
+	TotalPage  int
+	Shelf      string
+	DateRead   string
+	DateAdded  string
+}
+
+// Clipping is a single entry of a Kindle My Clippings.txt file or of a Kobo annotation export, Line is where
+// the entry starts. Page is 0 and ClippedAt is nil when the entry does not have them
+type Clipping struct {
+	Line       int
+	Title      string
+	AuthorName string
+	Kind       string
+	Location   string
+	Page       int
+	ClippedAt  *time.Time
+	Text       string
+}
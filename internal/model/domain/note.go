@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Types of a note, they match the note_type enum
+const (
+	NoteTypeNote      = "note"
+	NoteTypeHighlight = "highlight"
+	NoteTypeQuote     = "quote"
+)
+
+// Note is a note, highlight or quote taken from a book, Page is 0 when the note has no page reference
+type Note struct {
+	Id        string    `json:"id"`
+	BookId    string    `json:"book_id"`
+	Type      string    `json:"type"`
+	Text      string    `json:"text"`
+	Page      int       `json:"page,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NoteSearchResult is a note matching a search, Snippet marks the matching words with
+// helper.SnippetStartSel and helper.SnippetStopSel
+type NoteSearchResult struct {
+	Id       string  `json:"id"`
+	BookId   string  `json:"book_id"`
+	BookName string  `json:"book_name"`
+	Type     string  `json:"type"`
+	Page     int     `json:"page,omitempty"`
+	Snippet  string  `json:"snippet"`
+	Rank     float64 `json:"rank"`
+}
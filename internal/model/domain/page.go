@@ -0,0 +1,8 @@
+package domain
+
+// Page describes which slice of a sorted result set a repository should return
+type Page struct {
+	Sort   string
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Outcomes of a read, a read in progress has no outcome yet
+const (
+	ReadOutcomeFinished  = "finished"
+	ReadOutcomeAbandoned = "abandoned"
+)
+
+// Read is a single read-through of a book. FinishedDate and Outcome are empty while the read is in progress,
+// Rating is 0 when the read was not rated
+type Read struct {
+	Id           string    `json:"id"`
+	BookId       string    `json:"book_id"`
+	StartedDate  string    `json:"started_date"`
+	FinishedDate string    `json:"finished_date,omitempty"`
+	Outcome      string    `json:"outcome,omitempty"`
+	Rating       float64   `json:"rating,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+type ReadingSession struct {
+	Id          string    `json:"id"`
+	BookId      string    `json:"book_id"`
+	SessionDate string    `json:"session_date"`
+	StartPage   int       `json:"start_page"`
+	EndPage     int       `json:"end_page"`
+	MinutesRead int       `json:"minutes_read"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+type RefreshToken struct {
+	Id        string     `json:"id"`
+	UserId    string     `json:"user_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// Review is the current review of a book, Body is markdown and may be empty when only a rating is given
+type Review struct {
+	Id        string    `json:"id"`
+	BookId    string    `json:"book_id"`
+	Rating    float64   `json:"rating"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ReviewRevision is an earlier version of a review, kept whenever the review is edited
+type ReviewRevision struct {
+	Id        string    `json:"id"`
+	ReviewId  string    `json:"review_id"`
+	Rating    float64   `json:"rating"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RatingBucket is the number of reviews that gave a single rating
+type RatingBucket struct {
+	Rating float64 `json:"rating"`
+	Count  int     `json:"count"`
+}
+
+// AuthorRating aggregates the reviews of the books an author wrote,
+// Distribution has a bucket for every rating from 0.5 to 5 including empty ones
+type AuthorRating struct {
+	AuthorId     string         `json:"author_id"`
+	FullName     string         `json:"full_name"`
+	Average      float64        `json:"average"`
+	Count        int            `json:"count"`
+	Distribution []RatingBucket `json:"distribution"`
+}
@@ -0,0 +1,18 @@
+package domain
+
+const (
+	SearchScopeAll     = "all"
+	SearchScopeBooks   = "books"
+	SearchScopeAuthors = "authors"
+
+	SearchTypeBook   = "book"
+	SearchTypeAuthor = "author"
+)
+
+type SearchResult struct {
+	Type    string  `json:"type"`
+	Id      string  `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// Series groups books into ordered volumes, AuthorId is empty when the series has no single author
+type Series struct {
+	Id        string    `json:"id"`
+	OwnerId   string    `json:"owner_id"`
+	Name      string    `json:"name"`
+	AuthorId  string    `json:"author_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// Groupings of the statistics timeline, they are date_trunc fields
+const (
+	StatsGroupByDay   = "day"
+	StatsGroupByWeek  = "week"
+	StatsGroupByMonth = "month"
+	StatsGroupByYear  = "year"
+)
+
+// ReadingTotals is what the owner read between two dates. Books counts finished reads so a re-read counts again,
+// Rereads is how many of them were re-reads. Pages counts logged pages and the total_page of finished reads
+// of books that have no reading session. AverageBookLength counts every book once
+type ReadingTotals struct {
+	Books             int     `json:"books"`
+	Rereads           int     `json:"rereads"`
+	Pages             int     `json:"pages"`
+	AverageBookLength float64 `json:"average_book_length"`
+}
+
+// StatsBucket is a step of the statistics timeline, PeriodStart is the first day of the day, week, month or year
+type StatsBucket struct {
+	PeriodStart    time.Time `json:"period_start"`
+	BooksCompleted int       `json:"books_completed"`
+	PagesRead      int       `json:"pages_read"`
+}
+
+type StatusCount struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+// AuthorStats counts the books of an author completed in the range, Pages is the sum of their total_page
+type AuthorStats struct {
+	AuthorId       string `json:"author_id"`
+	FullName       string `json:"full_name"`
+	BooksCompleted int    `json:"books_completed"`
+	Pages          int    `json:"pages"`
+}
+
+type NationalityStats struct {
+	Nationality    string `json:"nationality"`
+	BooksCompleted int    `json:"books_completed"`
+	Authors        int    `json:"authors"`
+}
+
+// Stats gathers every aggregate of the statistics endpoint
+type Stats struct {
+	Totals        ReadingTotals      `json:"totals"`
+	Timeline      []StatsBucket      `json:"timeline"`
+	Status        []StatusCount      `json:"status"`
+	TopAuthors    []AuthorStats      `json:"top_authors"`
+	Nationalities []NationalityStats `json:"nationalities"`
+}
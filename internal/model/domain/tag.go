@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Tag is a genre or any other label a user puts on their books,
+// BookCount is how many of the owner's books carry the tag
+type Tag struct {
+	Id        string    `json:"id"`
+	OwnerId   string    `json:"owner_id"`
+	Name      string    `json:"name"`
+	BookCount int       `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
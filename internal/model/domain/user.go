@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+type User struct {
+	Id        string    `json:"id"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	Password  string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
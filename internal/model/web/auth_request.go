@@ -10,3 +10,11 @@ type LoginRequest struct {
 	Email    string `json:"email" validate:"required,max=255,email"`
 	Password string `json:"password" validate:"required,min=8,validPassword"`
 }
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
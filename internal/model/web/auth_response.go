@@ -10,6 +10,15 @@ type CreateUserResponse struct {
 }
 
 type LoginResponse struct {
-	Id       string `json:"id"`
-	FullName string `json:"full_name"`
+	Id                   string    `json:"id"`
+	FullName             string    `json:"full_name"`
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	RefreshToken         string    `json:"refresh_token"`
+}
+
+type RefreshTokenResponse struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	RefreshToken         string    `json:"refresh_token"`
 }
@@ -0,0 +1,18 @@
+package web
+
+type CreateAuthorAliasRequest struct {
+	Name string `json:"name" validate:"required,min=3,max=255,validName"`
+}
+
+type PathParamsAuthorAliases struct {
+	AuthorId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsAuthorAlias struct {
+	AuthorId string `json:"id" validate:"omitempty,uuid"`
+	AliasId  string `json:"alias_id" validate:"omitempty,uuid"`
+}
+
+type UpdateAuthorAliasRequest struct {
+	Name string `json:"name" validate:"required,min=3,max=255,validName"`
+}
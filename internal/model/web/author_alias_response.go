@@ -0,0 +1,11 @@
+package web
+
+import "time"
+
+type AuthorAliasResponse struct {
+	Id        string    `json:"id"`
+	AuthorId  string    `json:"author_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
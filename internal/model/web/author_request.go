@@ -1,13 +1,25 @@
 package web
 
+// CreateAuthorRequest takes the profile of the author next to the name, photo_key is a portrait uploaded
+// through the author presigned URL
 type CreateAuthorRequest struct {
-	FullName    string `json:"full_name" validate:"required,min=3,max=255,validName"`
-	Nationality string `json:"nationality" validate:"required,min=3,max=255,alpha"`
+	FullName      string `json:"full_name" validate:"required,min=3,max=255,validName"`
+	Nationality   string `json:"nationality" validate:"required,min=3,max=255,validName"`
+	BirthDate     string `json:"birth_date" validate:"omitempty,datetime=2006-01-02"`
+	DeathDate     string `json:"death_date" validate:"omitempty,datetime=2006-01-02"`
+	Bio           string `json:"bio" validate:"omitempty,max=10000"`
+	Website       string `json:"website" validate:"omitempty,max=255,http_url"`
+	OpenLibraryId string `json:"open_library_id" validate:"omitempty,openLibraryAuthorId"`
+	WikidataId    string `json:"wikidata_id" validate:"omitempty,wikidataId"`
+	PhotoKey      string `json:"photo_key" validate:"omitempty,min=6,max=255,validPhotoKey,authorPhotoKey"`
 }
 
 type QueryParamsGetAuthors struct {
 	FullName    string `json:"full_name" validate:"omitempty,min=3,max=255,validName"`
-	Nationality string `json:"nationality" validate:"omitempty,min=3,max=255,alpha"`
+	Nationality string `json:"nationality" validate:"omitempty,min=3,max=255,validName"`
+	Page        int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize    int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort        string `json:"sort" validate:"omitempty,oneof=name -name created_at -created_at"`
 }
 
 type PathParamsGetAuthor struct {
@@ -22,7 +34,31 @@ type PathParamsDeleteAuthor struct {
 	Id string `json:"id" validate:"omitempty,uuid"`
 }
 
+// QueryParamsDeleteAuthor tells what happens to the books of the author, they either block the deletion,
+// are deleted with the author, or are moved to the author reassign_to
+type QueryParamsDeleteAuthor struct {
+	Mode       string `json:"mode" validate:"omitempty,oneof=restrict cascade"`
+	ReassignTo string `json:"reassign_to" validate:"omitempty,uuid,excluded_with=Mode"`
+}
+
+// UpdateAuthorRequest replaces the whole profile, a field left out is cleared
 type UpdateAuthorRequest struct {
-	FullName    string `json:"full_name" validate:"required,min=3,max=255,validName"`
-	Nationality string `json:"nationality" validate:"required,min=3,max=255,alpha"`
+	FullName      string `json:"full_name" validate:"required,min=3,max=255,validName"`
+	Nationality   string `json:"nationality" validate:"required,min=3,max=255,validName"`
+	BirthDate     string `json:"birth_date" validate:"omitempty,datetime=2006-01-02"`
+	DeathDate     string `json:"death_date" validate:"omitempty,datetime=2006-01-02"`
+	Bio           string `json:"bio" validate:"omitempty,max=10000"`
+	Website       string `json:"website" validate:"omitempty,max=255,http_url"`
+	OpenLibraryId string `json:"open_library_id" validate:"omitempty,openLibraryAuthorId"`
+	WikidataId    string `json:"wikidata_id" validate:"omitempty,wikidataId"`
+	PhotoKey      string `json:"photo_key" validate:"omitempty,min=6,max=255,validPhotoKey,authorPhotoKey"`
+}
+
+type PathParamsMergeAuthors struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+// MergeAuthorsRequest lists the authors that are merged into the author of the path and then deleted
+type MergeAuthorsRequest struct {
+	DuplicateIds []string `json:"duplicate_ids" validate:"required,gt=0,max=20,unique,dive,uuid"`
 }
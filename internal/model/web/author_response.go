@@ -3,25 +3,68 @@ package web
 import "time"
 
 type CreateAuthorResponse struct {
-	Id          string    `json:"id"`
-	FullName    string    `json:"full_name"`
-	Nationality string    `json:"nationality"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id            string    `json:"id"`
+	FullName      string    `json:"full_name"`
+	Nationality   string    `json:"nationality"`
+	BirthDate     string    `json:"birth_date,omitempty"`
+	DeathDate     string    `json:"death_date,omitempty"`
+	Bio           string    `json:"bio,omitempty"`
+	Website       string    `json:"website,omitempty"`
+	OpenLibraryId string    `json:"open_library_id,omitempty"`
+	WikidataId    string    `json:"wikidata_id,omitempty"`
+	PhotoKey      string    `json:"photo_key,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
+// GetAuthorResponse.PhotoURL is a presigned URL of the portrait, it expires after a day
 type GetAuthorResponse struct {
-	Id          string    `json:"id"`
-	FullName    string    `json:"full_name"`
-	Nationality string    `json:"nationality"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id            string    `json:"id"`
+	FullName      string    `json:"full_name"`
+	Nationality   string    `json:"nationality"`
+	BirthDate     string    `json:"birth_date,omitempty"`
+	DeathDate     string    `json:"death_date,omitempty"`
+	Bio           string    `json:"bio,omitempty"`
+	Website       string    `json:"website,omitempty"`
+	OpenLibraryId string    `json:"open_library_id,omitempty"`
+	WikidataId    string    `json:"wikidata_id,omitempty"`
+	PhotoKey      string    `json:"photo_key,omitempty"`
+	PhotoURL      string    `json:"photo_url,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 type UpdateAuthorResponse struct {
-	Id          string    `json:"id"`
-	FullName    string    `json:"full_name"`
-	Nationality string    `json:"nationality"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id            string    `json:"id"`
+	FullName      string    `json:"full_name"`
+	Nationality   string    `json:"nationality"`
+	BirthDate     string    `json:"birth_date,omitempty"`
+	DeathDate     string    `json:"death_date,omitempty"`
+	Bio           string    `json:"bio,omitempty"`
+	Website       string    `json:"website,omitempty"`
+	OpenLibraryId string    `json:"open_library_id,omitempty"`
+	WikidataId    string    `json:"wikidata_id,omitempty"`
+	PhotoKey      string    `json:"photo_key,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// MergeAuthorsResponse is the surviving author with every alias it has after the merge,
+// Collisions are the books of the user that had the same name and were merged into one
+type MergeAuthorsResponse struct {
+	Id          string                  `json:"id"`
+	FullName    string                  `json:"full_name"`
+	Nationality string                  `json:"nationality"`
+	Aliases     []string                `json:"aliases"`
+	MergedIds   []string                `json:"merged_ids"`
+	Collisions  []BookCollisionResponse `json:"collisions"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// BookCollisionResponse is the book the books with MergedBookIds were merged into
+type BookCollisionResponse struct {
+	Name          string   `json:"name"`
+	BookId        string   `json:"book_id"`
+	MergedBookIds []string `json:"merged_book_ids"`
 }
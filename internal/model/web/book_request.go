@@ -1,18 +1,46 @@
 package web
 
+// BookContributorRequest is one entry of the contributors list, the list order is kept as the display order
+// alias_id is the alias of the author the book was published under
+type BookContributorRequest struct {
+	AuthorId string `json:"author_id" validate:"required,uuid"`
+	Role     string `json:"role" validate:"required,contributorRole"`
+	AliasId  string `json:"alias_id" validate:"omitempty,uuid"`
+}
+
+// CreateBookRequest takes either author_id for a single author or a list of contributors,
+// contributors wins when both are given. author_alias_id goes with author_id like alias_id goes with a contributor
 type CreateBookRequest struct {
-	Name          string `json:"name" validate:"required,min=3,max=255"`
-	TotalPage     int    `json:"total_page" validate:"required,number,min=1,max=12000"`
-	AuthorId      string `json:"author_id" validate:"required,uuid"`
-	PhotoKey      string `json:"photo_key" validate:"required,min=6,max=255,validPhotoKey"`
-	Status        string `json:"status" validate:"required,bookStatus"`
-	CompletedDate string `json:"completed_date" validate:"omitempty,datetime=2006-01-02"`
+	Name            string   `json:"name" validate:"required,min=3,max=255"`
+	TotalPage       int      `json:"total_page" validate:"required,number,min=1,max=12000"`
+	CurrentPage     int      `json:"current_page" validate:"omitempty,number,min=0,max=12000"`
+	AuthorId        string   `json:"author_id" validate:"required_without=Contributors,omitempty,uuid"`
+	AuthorAliasId   string   `json:"author_alias_id" validate:"omitempty,uuid,excluded_without=AuthorId"`
+	PhotoKey        string   `json:"photo_key" validate:"required,min=6,max=255,validPhotoKey"`
+	Status          string   `json:"status" validate:"required,bookStatus"`
+	CompletedDate   string   `json:"completed_date" validate:"omitempty,datetime=2006-01-02"`
+	Isbn            string   `json:"isbn" validate:"omitempty,validIsbn"`
+	Publisher       string   `json:"publisher" validate:"omitempty,max=255"`
+	PublicationYear int      `json:"publication_year" validate:"omitempty,number,min=1,max=9999"`
+	Language        string   `json:"language" validate:"omitempty,max=35,bcp47_language_tag"`
+	SeriesId        string   `json:"series_id" validate:"omitempty,uuid"`
+	SeriesPosition  *float64 `json:"series_position" validate:"required_with=SeriesId,excluded_without=SeriesId,omitempty,min=0,max=9999.99"`
+
+	Contributors []BookContributorRequest `json:"contributors" validate:"required_without=AuthorId,omitempty,max=20,dive"`
 }
 
 type QueryParamsGetBooks struct {
-	Status     string `json:"status" validate:"omitempty,bookStatus"`
-	Name       string `json:"name" validate:"omitempty,min=3,max=255"`
-	AuthorName string `json:"author_name" validate:"omitempty,min=3,max=255,validName"`
+	Status        string   `json:"status" validate:"omitempty,bookStatus"`
+	Name          string   `json:"name" validate:"omitempty,min=3,max=255"`
+	AuthorName    string   `json:"author_name" validate:"omitempty,min=3,max=255,validName"`
+	CompletedFrom string   `json:"completed_from" validate:"omitempty,datetime=2006-01-02"`
+	CompletedTo   string   `json:"completed_to" validate:"omitempty,datetime=2006-01-02"`
+	RatingMin     float64  `json:"rating_min" validate:"omitempty,validRating"`
+	Tags          []string `json:"tags" validate:"omitempty,max=10,dive,required,max=50"`
+	TagsMatch     string   `json:"tags_match" validate:"omitempty,oneof=all any"`
+	Page          int      `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize      int      `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort          string   `json:"sort" validate:"omitempty,oneof=name -name created_at -created_at total_page -total_page rating -rating"`
 }
 
 type PathParamsGetBook struct {
@@ -24,14 +52,28 @@ type PathParamsUpdateBook struct {
 }
 
 type UpdateBookRequest struct {
-	Name          string `json:"name" validate:"required,min=3,max=255"`
-	TotalPage     int    `json:"total_page" validate:"required,number,min=1,max=12000"`
-	AuthorId      string `json:"author_id" validate:"required,uuid"`
-	PhotoKey      string `json:"photo_key" validate:"required,min=3,max=255"`
-	Status        string `json:"status" validate:"required,bookStatus"`
-	CompletedDate string `json:"completed_date" validate:"omitempty,datetime=2006-01-02"`
+	Name            string   `json:"name" validate:"required,min=3,max=255"`
+	TotalPage       int      `json:"total_page" validate:"required,number,min=1,max=12000"`
+	CurrentPage     int      `json:"current_page" validate:"omitempty,number,min=0,max=12000"`
+	AuthorId        string   `json:"author_id" validate:"required_without=Contributors,omitempty,uuid"`
+	AuthorAliasId   string   `json:"author_alias_id" validate:"omitempty,uuid,excluded_without=AuthorId"`
+	PhotoKey        string   `json:"photo_key" validate:"required,min=3,max=255"`
+	Status          string   `json:"status" validate:"required,bookStatus"`
+	CompletedDate   string   `json:"completed_date" validate:"omitempty,datetime=2006-01-02"`
+	Isbn            string   `json:"isbn" validate:"omitempty,validIsbn"`
+	Publisher       string   `json:"publisher" validate:"omitempty,max=255"`
+	PublicationYear int      `json:"publication_year" validate:"omitempty,number,min=1,max=9999"`
+	Language        string   `json:"language" validate:"omitempty,max=35,bcp47_language_tag"`
+	SeriesId        string   `json:"series_id" validate:"omitempty,uuid"`
+	SeriesPosition  *float64 `json:"series_position" validate:"required_with=SeriesId,excluded_without=SeriesId,omitempty,min=0,max=9999.99"`
+
+	Contributors []BookContributorRequest `json:"contributors" validate:"required_without=AuthorId,omitempty,max=20,dive"`
 }
 
 type PathParamsDeleteBook struct {
 	Id string `json:"id" validate:"omitempty,uuid"`
 }
+
+type LookupBookRequest struct {
+	Isbn string `json:"isbn" validate:"required,validIsbn"`
+}
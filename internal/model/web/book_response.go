@@ -2,38 +2,90 @@ package web
 
 import "time"
 
+type BookContributorResponse struct {
+	AuthorId    string `json:"author_id"`
+	FullName    string `json:"full_name,omitempty"`
+	Role        string `json:"role"`
+	Position    int    `json:"position"`
+	AliasId     string `json:"alias_id,omitempty"`
+	PublishedAs string `json:"published_as,omitempty"`
+}
+
+type BookTagResponse struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type CreateBookResponse struct {
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
-	TotalPage     int       `json:"total_page"`
-	AuthorId      string    `json:"author_id"`
-	PhotoKey      string    `json:"photo_key"`
-	Status        string    `json:"status"`
-	CompletedDate string    `json:"completed_date"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	Id              string                    `json:"id"`
+	Name            string                    `json:"name"`
+	TotalPage       int                       `json:"total_page"`
+	CurrentPage     int                       `json:"current_page"`
+	AuthorId        string                    `json:"author_id"`
+	PhotoKey        string                    `json:"photo_key"`
+	Status          string                    `json:"status"`
+	CompletedDate   string                    `json:"completed_date"`
+	Isbn            string                    `json:"isbn"`
+	Publisher       string                    `json:"publisher"`
+	PublicationYear int                       `json:"publication_year"`
+	Language        string                    `json:"language"`
+	SeriesId        string                    `json:"series_id"`
+	SeriesPosition  float64                   `json:"series_position"`
+	Contributors    []BookContributorResponse `json:"contributors"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
 }
 
 type GetBookResponse struct {
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
-	TotalPage     int       `json:"total_page"`
-	AuthorId      string    `json:"author_id"`
-	PhotoURL      string    `json:"photo_url"`
-	Status        string    `json:"status"`
-	CompletedDate string    `json:"completed_date"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	Id              string                    `json:"id"`
+	Name            string                    `json:"name"`
+	TotalPage       int                       `json:"total_page"`
+	CurrentPage     int                       `json:"current_page"`
+	AuthorId        string                    `json:"author_id"`
+	PhotoURL        string                    `json:"photo_url"`
+	Status          string                    `json:"status"`
+	CompletedDate   string                    `json:"completed_date"`
+	Isbn            string                    `json:"isbn"`
+	Publisher       string                    `json:"publisher"`
+	PublicationYear int                       `json:"publication_year"`
+	Language        string                    `json:"language"`
+	SeriesId        string                    `json:"series_id"`
+	SeriesPosition  float64                   `json:"series_position"`
+	Rating          float64                   `json:"rating"`
+	Contributors    []BookContributorResponse `json:"contributors"`
+	Tags            []BookTagResponse         `json:"tags"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
 }
 
 type UpdateBookResponse struct {
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
-	TotalPage     int       `json:"total_page"`
-	AuthorId      string    `json:"author_id"`
-	PhotoKey      string    `json:"photo_key"`
-	Status        string    `json:"status"`
-	CompletedDate string    `json:"completed_date"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	Id              string                    `json:"id"`
+	Name            string                    `json:"name"`
+	TotalPage       int                       `json:"total_page"`
+	CurrentPage     int                       `json:"current_page"`
+	AuthorId        string                    `json:"author_id"`
+	PhotoKey        string                    `json:"photo_key"`
+	Status          string                    `json:"status"`
+	CompletedDate   string                    `json:"completed_date"`
+	Isbn            string                    `json:"isbn"`
+	Publisher       string                    `json:"publisher"`
+	PublicationYear int                       `json:"publication_year"`
+	Language        string                    `json:"language"`
+	SeriesId        string                    `json:"series_id"`
+	SeriesPosition  float64                   `json:"series_position"`
+	Contributors    []BookContributorResponse `json:"contributors"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+type LookupAuthorResponse struct {
+	FullName string `json:"full_name"`
+	AuthorId string `json:"author_id,omitempty"`
+}
+
+// LookupBookResponse holds a CreateBookRequest pre-filled from a metadata provider,
+// author_id is only filled when the first author already exists in the catalog
+type LookupBookResponse struct {
+	Book    CreateBookRequest      `json:"book"`
+	Authors []LookupAuthorResponse `json:"authors"`
 }
@@ -0,0 +1,5 @@
+package web
+
+type QueryParamsExportBooks struct {
+	Format string `json:"format" validate:"omitempty,oneof=json csv goodreads"`
+}
@@ -0,0 +1,25 @@
+package web
+
+import "time"
+
+type ExportAuthorResponse struct {
+	Id          string `json:"id"`
+	FullName    string `json:"full_name"`
+	Nationality string `json:"nationality"`
+}
+
+type ExportBookResponse struct {
+	Id              string               `json:"id"`
+	Name            string               `json:"name"`
+	TotalPage       int                  `json:"total_page"`
+	CurrentPage     int                  `json:"current_page"`
+	Status          string               `json:"status"`
+	CompletedDate   string               `json:"completed_date"`
+	Isbn            string               `json:"isbn"`
+	Publisher       string               `json:"publisher"`
+	PublicationYear int                  `json:"publication_year"`
+	Language        string               `json:"language"`
+	Author          ExportAuthorResponse `json:"author"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
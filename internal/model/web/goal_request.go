@@ -0,0 +1,31 @@
+package web
+
+type CreateGoalRequest struct {
+	Metric  string `json:"metric" validate:"required,oneof=books pages"`
+	Target  int    `json:"target" validate:"required,number,min=1,max=1000000"`
+	Period  string `json:"period" validate:"required,oneof=year quarter month"`
+	Year    int    `json:"year" validate:"required,number,min=1000,max=9999"`
+	Quarter int    `json:"quarter" validate:"required_if=Period quarter,excluded_unless=Period quarter,omitempty,min=1,max=4"`
+	Month   int    `json:"month" validate:"required_if=Period month,excluded_unless=Period month,omitempty,min=1,max=12"`
+}
+
+type QueryParamsGetGoals struct {
+	Metric   string `json:"metric" validate:"omitempty,oneof=books pages"`
+	Year     int    `json:"year" validate:"omitempty,min=1000,max=9999"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=start_date -start_date created_at -created_at"`
+}
+
+type PathParamsGoal struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type UpdateGoalRequest struct {
+	Metric  string `json:"metric" validate:"required,oneof=books pages"`
+	Target  int    `json:"target" validate:"required,number,min=1,max=1000000"`
+	Period  string `json:"period" validate:"required,oneof=year quarter month"`
+	Year    int    `json:"year" validate:"required,number,min=1000,max=9999"`
+	Quarter int    `json:"quarter" validate:"required_if=Period quarter,excluded_unless=Period quarter,omitempty,min=1,max=4"`
+	Month   int    `json:"month" validate:"required_if=Period month,excluded_unless=Period month,omitempty,min=1,max=12"`
+}
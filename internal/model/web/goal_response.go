@@ -0,0 +1,46 @@
+package web
+
+import "time"
+
+type GoalResponse struct {
+	Id        string    `json:"id"`
+	Metric    string    `json:"metric"`
+	Target    int       `json:"target"`
+	Period    string    `json:"period"`
+	Year      int       `json:"year"`
+	Quarter   int       `json:"quarter,omitempty"`
+	Month     int       `json:"month,omitempty"`
+	StartDate string    `json:"start_date"`
+	EndDate   string    `json:"end_date"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Statuses of a goal progress
+const (
+	GoalStatusNotStarted = "not_started"
+	GoalStatusOnTrack    = "on_track"
+	GoalStatusBehind     = "behind"
+	GoalStatusAchieved   = "achieved"
+	GoalStatusMissed     = "missed"
+)
+
+// GoalProgressResponse compares the actual pace with the pace the target needs, paces are per week
+type GoalProgressResponse struct {
+	GoalId              string  `json:"goal_id"`
+	Metric              string  `json:"metric"`
+	Target              int     `json:"target"`
+	StartDate           string  `json:"start_date"`
+	EndDate             string  `json:"end_date"`
+	Current             int     `json:"current"`
+	Remaining           int     `json:"remaining"`
+	Percent             float64 `json:"percent"`
+	Expected            float64 `json:"expected"`
+	DaysTotal           int     `json:"days_total"`
+	DaysElapsed         int     `json:"days_elapsed"`
+	DaysLeft            int     `json:"days_left"`
+	ExpectedPacePerWeek float64 `json:"expected_pace_per_week"`
+	ActualPacePerWeek   float64 `json:"actual_pace_per_week"`
+	RequiredPacePerWeek float64 `json:"required_pace_per_week"`
+	Status              string  `json:"status"`
+}
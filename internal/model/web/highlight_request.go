@@ -0,0 +1,12 @@
+package web
+
+type PathParamsBookHighlights struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type QueryParamsGetHighlights struct {
+	Type     string `json:"type" validate:"omitempty,oneof=note highlight"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=location -location clipped_at -clipped_at"`
+}
@@ -0,0 +1,14 @@
+package web
+
+import "time"
+
+type HighlightResponse struct {
+	Id        string     `json:"id"`
+	BookId    string     `json:"book_id"`
+	Type      string     `json:"type"`
+	Text      string     `json:"text"`
+	Location  string     `json:"location"`
+	Page      int        `json:"page"`
+	ClippedAt *time.Time `json:"clipped_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package web
+
+type QueryParamsImportBooks struct {
+	BatchSize        int `json:"batch_size" validate:"omitempty,min=1,max=1000"`
+	DefaultTotalPage int `json:"default_total_page" validate:"omitempty,min=1,max=12000"`
+}
+
+// ImportBookRowRequest validates a CSV row with the same rules as CreateBookRequest and CreateAuthorRequest
+type ImportBookRowRequest struct {
+	Name          string `json:"name" validate:"required,min=3,max=255"`
+	AuthorName    string `json:"author_name" validate:"required,min=3,max=255,validName"`
+	TotalPage     int    `json:"total_page" validate:"required,number,min=1,max=12000"`
+	Status        string `json:"status" validate:"required,bookStatus"`
+	CompletedDate string `json:"completed_date" validate:"omitempty,datetime=2006-01-02"`
+}
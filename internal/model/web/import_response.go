@@ -0,0 +1,54 @@
+package web
+
+const (
+	ImportResultCreated = "created"
+	ImportResultSkipped = "skipped"
+	ImportResultFailed  = "failed"
+)
+
+type ImportRowResponse struct {
+	Line          int    `json:"line"`
+	Title         string `json:"title"`
+	AuthorName    string `json:"author_name"`
+	Result        string `json:"result"`
+	BookId        string `json:"book_id,omitempty"`
+	AuthorId      string `json:"author_id,omitempty"`
+	AuthorCreated bool   `json:"author_created"`
+	Message       string `json:"message,omitempty"`
+}
+
+type ImportBooksResponse struct {
+	Format  string              `json:"format"`
+	Created int                 `json:"created"`
+	Skipped int                 `json:"skipped"`
+	Failed  int                 `json:"failed"`
+	Rows    []ImportRowResponse `json:"rows"`
+}
+
+// ImportHighlightsBookResponse counts the clippings of a title in the file that was matched to a book on the shelf
+type ImportHighlightsBookResponse struct {
+	Title      string `json:"title"`
+	AuthorName string `json:"author_name"`
+	BookId     string `json:"book_id"`
+	BookName   string `json:"book_name"`
+	Imported   int    `json:"imported"`
+	Duplicates int    `json:"duplicates"`
+}
+
+// UnmatchedClippingsResponse is a title in the file without a book on the shelf, Book is a prefilled
+// request to create it so the clippings can be imported by running the import again
+type UnmatchedClippingsResponse struct {
+	Title      string            `json:"title"`
+	AuthorName string            `json:"author_name"`
+	Clippings  int               `json:"clippings"`
+	Book       CreateBookRequest `json:"book"`
+}
+
+type ImportHighlightsResponse struct {
+	Format     string                         `json:"format"`
+	Imported   int                            `json:"imported"`
+	Duplicates int                            `json:"duplicates"`
+	Skipped    int                            `json:"skipped"`
+	Books      []ImportHighlightsBookResponse `json:"books"`
+	Unmatched  []UnmatchedClippingsResponse   `json:"unmatched"`
+}
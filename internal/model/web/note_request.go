@@ -0,0 +1,36 @@
+package web
+
+type CreateNoteRequest struct {
+	Type string `json:"type" validate:"required,noteType"`
+	Text string `json:"text" validate:"required,min=1,max=10000"`
+	Page int    `json:"page" validate:"omitempty,number,min=1,max=12000"`
+}
+
+type PathParamsBookNotes struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type QueryParamsGetNotes struct {
+	Type     string `json:"type" validate:"omitempty,noteType"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=page -page created_at -created_at"`
+}
+
+type PathParamsBookNote struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+	NoteId string `json:"note_id" validate:"omitempty,uuid"`
+}
+
+type UpdateNoteRequest struct {
+	Type string `json:"type" validate:"required,noteType"`
+	Text string `json:"text" validate:"required,min=1,max=10000"`
+	Page int    `json:"page" validate:"omitempty,number,min=1,max=12000"`
+}
+
+type QueryParamsSearchNotes struct {
+	Query    string `json:"q" validate:"required,min=2,max=255"`
+	Type     string `json:"type" validate:"omitempty,noteType"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,23 @@
+package web
+
+import "time"
+
+type NoteResponse struct {
+	Id        string    `json:"id"`
+	BookId    string    `json:"book_id"`
+	Type      string    `json:"type"`
+	Text      string    `json:"text"`
+	Page      int       `json:"page"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NoteSearchResultResponse struct {
+	Id       string  `json:"id"`
+	BookId   string  `json:"book_id"`
+	BookName string  `json:"book_name"`
+	Type     string  `json:"type"`
+	Page     int     `json:"page"`
+	Snippet  string  `json:"snippet"`
+	Rank     float64 `json:"rank"`
+}
@@ -0,0 +1,9 @@
+package web
+
+type PaginationMeta struct {
+	Page       int  `json:"page"`
+	PageSize   int  `json:"page_size"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"total_pages"`
+	NextPage   *int `json:"next_page"`
+}
@@ -0,0 +1,21 @@
+package web
+
+type PathParamsBookReads struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsBookRead struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+	ReadId string `json:"read_id" validate:"omitempty,uuid"`
+}
+
+// StartReadRequest starts a read-through on started_date, today when it is not given
+type StartReadRequest struct {
+	StartedDate string `json:"started_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// EndReadRequest finishes or abandons a read in progress on finished_date, today when it is not given
+type EndReadRequest struct {
+	FinishedDate string  `json:"finished_date" validate:"omitempty,datetime=2006-01-02"`
+	Rating       float64 `json:"rating" validate:"omitempty,validRating"`
+}
@@ -0,0 +1,22 @@
+package web
+
+import "time"
+
+type ReadResponse struct {
+	Id           string    `json:"id"`
+	BookId       string    `json:"book_id"`
+	StartedDate  string    `json:"started_date"`
+	FinishedDate string    `json:"finished_date,omitempty"`
+	Outcome      string    `json:"outcome,omitempty"`
+	Rating       float64   `json:"rating,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ChangeReadResponse is a read that was started, finished or abandoned with the book it leaves behind
+type ChangeReadResponse struct {
+	Read          ReadResponse `json:"read"`
+	CurrentPage   int          `json:"current_page"`
+	Status        string       `json:"status"`
+	CompletedDate string       `json:"completed_date"`
+}
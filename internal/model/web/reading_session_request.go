@@ -0,0 +1,16 @@
+package web
+
+type CreateReadingSessionRequest struct {
+	SessionDate string `json:"session_date" validate:"required,datetime=2006-01-02"`
+	StartPage   int    `json:"start_page" validate:"number,min=0,max=12000"`
+	EndPage     int    `json:"end_page" validate:"required,number,min=1,max=12000"`
+	MinutesRead int    `json:"minutes_read" validate:"omitempty,number,min=1,max=1440"`
+}
+
+type PathParamsCreateReadingSession struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsGetReadingSessions struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,21 @@
+package web
+
+import "time"
+
+type ReadingSessionResponse struct {
+	Id          string    `json:"id"`
+	BookId      string    `json:"book_id"`
+	SessionDate string    `json:"session_date"`
+	StartPage   int       `json:"start_page"`
+	EndPage     int       `json:"end_page"`
+	MinutesRead int       `json:"minutes_read"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CreateReadingSessionResponse struct {
+	Session       ReadingSessionResponse `json:"session"`
+	CurrentPage   int                    `json:"current_page"`
+	Status        string                 `json:"status"`
+	CompletedDate string                 `json:"completed_date"`
+}
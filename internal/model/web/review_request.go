@@ -0,0 +1,17 @@
+package web
+
+// PutReviewRequest replaces the current review of a book, body is markdown
+type PutReviewRequest struct {
+	Rating float64 `json:"rating" validate:"required,validRating"`
+	Body   string  `json:"body" validate:"omitempty,max=20000"`
+}
+
+type PathParamsBookReview struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+}
+
+type QueryParamsGetAuthorRatings struct {
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=average -average count -count name -name"`
+}
@@ -0,0 +1,32 @@
+package web
+
+import "time"
+
+type ReviewResponse struct {
+	Id        string    `json:"id"`
+	BookId    string    `json:"book_id"`
+	Rating    float64   `json:"rating"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReviewRevisionResponse struct {
+	Id        string    `json:"id"`
+	Rating    float64   `json:"rating"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RatingBucketResponse struct {
+	Rating float64 `json:"rating"`
+	Count  int     `json:"count"`
+}
+
+type AuthorRatingResponse struct {
+	AuthorId     string                 `json:"author_id"`
+	FullName     string                 `json:"full_name"`
+	Average      float64                `json:"average"`
+	Count        int                    `json:"count"`
+	Distribution []RatingBucketResponse `json:"distribution"`
+}
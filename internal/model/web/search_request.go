@@ -0,0 +1,8 @@
+package web
+
+type QueryParamsSearch struct {
+	Query    string `json:"q" validate:"required,min=2,max=255"`
+	Type     string `json:"type" validate:"omitempty,oneof=all books authors"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+}
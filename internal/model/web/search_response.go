@@ -0,0 +1,9 @@
+package web
+
+type SearchResultResponse struct {
+	Type    string  `json:"type"`
+	Id      string  `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
@@ -0,0 +1,31 @@
+package web
+
+type CreateSeriesRequest struct {
+	Name     string `json:"name" validate:"required,min=1,max=255"`
+	AuthorId string `json:"author_id" validate:"omitempty,uuid"`
+}
+
+type QueryParamsGetSeries struct {
+	Name     string `json:"name" validate:"omitempty,max=255"`
+	AuthorId string `json:"author_id" validate:"omitempty,uuid"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=name -name created_at -created_at"`
+}
+
+type PathParamsGetSeries struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsUpdateSeries struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type UpdateSeriesRequest struct {
+	Name     string `json:"name" validate:"required,min=1,max=255"`
+	AuthorId string `json:"author_id" validate:"omitempty,uuid"`
+}
+
+type PathParamsDeleteSeries struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
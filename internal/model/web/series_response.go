@@ -0,0 +1,38 @@
+package web
+
+import "time"
+
+type CreateSeriesResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	AuthorId  string    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetSeriesResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	AuthorId  string    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UpdateSeriesResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	AuthorId  string    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SeriesVolumeResponse is one of the owner's books in a series
+type SeriesVolumeResponse struct {
+	Id             string  `json:"id"`
+	Name           string  `json:"name"`
+	SeriesPosition float64 `json:"series_position"`
+	Status         string  `json:"status"`
+	CurrentPage    int     `json:"current_page"`
+	TotalPage      int     `json:"total_page"`
+	CompletedDate  string  `json:"completed_date"`
+}
@@ -0,0 +1,7 @@
+package web
+
+type QueryParamsGetStats struct {
+	From    string `json:"from" validate:"omitempty,datetime=2006-01-02"`
+	To      string `json:"to" validate:"omitempty,datetime=2006-01-02"`
+	GroupBy string `json:"group_by" validate:"omitempty,oneof=day week month year"`
+}
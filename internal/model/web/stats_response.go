@@ -0,0 +1,45 @@
+package web
+
+// StatsResponse aggregates the books completed and the pages read between From and To.
+// Status is a snapshot of the whole shelf and does not depend on the range
+type StatsResponse struct {
+	From          string                     `json:"from"`
+	To            string                     `json:"to"`
+	GroupBy       string                     `json:"group_by"`
+	Totals        StatsTotalsResponse        `json:"totals"`
+	Timeline      []StatsBucketResponse      `json:"timeline"`
+	Status        []StatusCountResponse      `json:"status"`
+	TopAuthors    []AuthorStatsResponse      `json:"top_authors"`
+	Nationalities []NationalityStatsResponse `json:"nationalities"`
+}
+
+type StatsTotalsResponse struct {
+	BooksCompleted    int     `json:"books_completed"`
+	Rereads           int     `json:"rereads"`
+	PagesRead         int     `json:"pages_read"`
+	AverageBookLength float64 `json:"average_book_length"`
+}
+
+type StatsBucketResponse struct {
+	PeriodStart    string `json:"period_start"`
+	BooksCompleted int    `json:"books_completed"`
+	PagesRead      int    `json:"pages_read"`
+}
+
+type StatusCountResponse struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+type AuthorStatsResponse struct {
+	AuthorId       string `json:"author_id"`
+	FullName       string `json:"full_name"`
+	BooksCompleted int    `json:"books_completed"`
+	Pages          int    `json:"pages"`
+}
+
+type NationalityStatsResponse struct {
+	Nationality    string `json:"nationality"`
+	BooksCompleted int    `json:"books_completed"`
+	Authors        int    `json:"authors"`
+}
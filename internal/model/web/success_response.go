@@ -3,4 +3,5 @@ package web
 type WebSuccessResponse struct {
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	Meta    interface{} `json:"meta,omitempty"`
 }
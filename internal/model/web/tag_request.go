@@ -0,0 +1,33 @@
+package web
+
+type CreateTagRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=50"`
+}
+
+type QueryParamsGetTags struct {
+	Name     string `json:"name" validate:"omitempty,max=50"`
+	Page     int    `json:"page" validate:"omitempty,min=1,max=10000"`
+	PageSize int    `json:"page_size" validate:"omitempty,min=1,max=100"`
+	Sort     string `json:"sort" validate:"omitempty,oneof=name -name book_count -book_count"`
+}
+
+type PathParamsGetTag struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsUpdateTag struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type UpdateTagRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=50"`
+}
+
+type PathParamsDeleteTag struct {
+	Id string `json:"id" validate:"omitempty,uuid"`
+}
+
+type PathParamsBookTag struct {
+	BookId string `json:"id" validate:"omitempty,uuid"`
+	TagId  string `json:"tag_id" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,26 @@
+package web
+
+import "time"
+
+type CreateTagResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GetTagResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	BookCount int       `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type UpdateTagResponse struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	BookCount int       `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -4,3 +4,8 @@ type GetBookPresignedURLResponse struct {
 	URL      string      `json:"url"`
 	FormData interface{} `json:"form_data"`
 }
+
+type GetAuthorPresignedURLResponse struct {
+	URL      string      `json:"url"`
+	FormData interface{} `json:"form_data"`
+}
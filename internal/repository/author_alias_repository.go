@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type AuthorAliasRepository interface {
+	Save(ctx context.Context, alias domain.AuthorAlias) (domain.AuthorAlias, error)
+	FindAllByAuthorId(ctx context.Context, authorId string) ([]domain.AuthorAlias, error)
+	FindById(ctx context.Context, authorId, aliasId string) (domain.AuthorAlias, error)
+	Update(ctx context.Context, alias domain.AuthorAlias) (domain.AuthorAlias, error)
+	Delete(ctx context.Context, aliasId string) error
+	ReassignAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewAuthorAliasRepository(db PgxDBTX) AuthorAliasRepository {
+	return &AuthorAliasRepositoryImpl{
+		DB: db,
+	}
+}
+
+type AuthorAliasRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *AuthorAliasRepositoryImpl) Save(ctx context.Context, alias domain.AuthorAlias) (domain.AuthorAlias, error) {
+	sqlQuery := `
+	INSERT INTO author_aliases (id, author_id, name)
+	VALUES ($1, $2, $3)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		alias.AuthorId,
+		alias.Name,
+	).Scan(
+		&alias.Id,
+		&alias.CreatedAt,
+		&alias.UpdatedAt,
+	)
+	if err != nil {
+		return domain.AuthorAlias{}, err
+	}
+
+	return alias, nil
+}
+
+func (repository *AuthorAliasRepositoryImpl) FindAllByAuthorId(ctx context.Context, authorId string) ([]domain.AuthorAlias, error) {
+	sqlQuery := `
+	SELECT id, author_id, name, created_at, updated_at
+	FROM author_aliases
+	WHERE author_id = $1
+	ORDER BY name ASC, id ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, authorId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	aliases := make([]domain.AuthorAlias, 0)
+
+	for rows.Next() {
+		var alias domain.AuthorAlias
+
+		err := rows.Scan(
+			&alias.Id,
+			&alias.AuthorId,
+			&alias.Name,
+			&alias.CreatedAt,
+			&alias.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		aliases = append(aliases, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+// FindById only finds aliases of the given author
+func (repository *AuthorAliasRepositoryImpl) FindById(ctx context.Context, authorId, aliasId string) (domain.AuthorAlias, error) {
+	sqlQuery := `
+	SELECT id, author_id, name, created_at, updated_at
+	FROM author_aliases
+	WHERE id = $1 AND author_id = $2
+	`
+
+	var alias domain.AuthorAlias
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, aliasId, authorId).Scan(
+		&alias.Id,
+		&alias.AuthorId,
+		&alias.Name,
+		&alias.CreatedAt,
+		&alias.UpdatedAt,
+	)
+	if err != nil {
+		return domain.AuthorAlias{}, err
+	}
+
+	return alias, nil
+}
+
+func (repository *AuthorAliasRepositoryImpl) Update(ctx context.Context, alias domain.AuthorAlias) (domain.AuthorAlias, error) {
+	sqlQuery := `
+	UPDATE author_aliases
+	SET name = $1, updated_at = $2
+	WHERE id = $3
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, alias.Name, time.Now(), alias.Id).Scan(
+		&alias.CreatedAt,
+		&alias.UpdatedAt,
+	)
+	if err != nil {
+		return domain.AuthorAlias{}, err
+	}
+
+	return alias, nil
+}
+
+// Delete keeps the books published under the alias, they fall back to the full_name of the author
+func (repository *AuthorAliasRepositoryImpl) Delete(ctx context.Context, aliasId string) error {
+	sqlQuery := `
+	DELETE FROM author_aliases
+	WHERE id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, aliasId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReassignAuthor moves every alias of an author to another author
+func (repository *AuthorAliasRepositoryImpl) ReassignAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	sqlQuery := `
+	UPDATE author_aliases
+	SET author_id = $2, updated_at = CURRENT_TIMESTAMP
+	WHERE author_id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, fromAuthorId, toAuthorId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
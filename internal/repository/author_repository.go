@@ -9,7 +9,10 @@ import (
 type AuthorRepository interface {
 	Save(ctx context.Context, author domain.Author) (domain.Author, error)
 	CheckByFullName(ctx context.Context, fullName string) error
-	FindAll(ctx context.Context, fullName, nationality string) ([]domain.Author, error)
+	CheckByExternalId(ctx context.Context, authorId, column, externalId string) error
+	CheckByPhotoKey(ctx context.Context, authorId, photoKey string) error
+	FindByFullName(ctx context.Context, fullName string) (domain.Author, error)
+	FindAll(ctx context.Context, fullName, nationality string, page domain.Page) ([]domain.Author, int, error)
 	FindById(ctx context.Context, authorId string) (domain.Author, error)
 	Update(ctx context.Context, authorId string, author domain.Author) (domain.Author, error)
 	Delete(ctx context.Context, authorId string) error
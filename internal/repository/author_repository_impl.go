@@ -24,8 +24,8 @@ type AuthorRepositoryImpl struct {
 
 func (repository *AuthorRepositoryImpl) Save(ctx context.Context, author domain.Author) (domain.Author, error) {
 	sqlQuery := `
-	INSERT INTO authors (id, full_name, nationality)
-	VALUES ($1, $2, $3)
+	INSERT INTO authors (id, full_name, nationality, birth_date, death_date, bio, website, open_library_id, wikidata_id, photo_key)
+	VALUES ($1, $2, $3, NULLIF($4, '')::date, NULLIF($5, '')::date, $6, $7, $8, $9, $10)
 	RETURNING id, created_at, updated_at
 	`
 
@@ -35,6 +35,13 @@ func (repository *AuthorRepositoryImpl) Save(ctx context.Context, author domain.
 		uuid.NewString(),
 		author.FullName,
 		author.Nationality,
+		author.BirthDate,
+		author.DeathDate,
+		author.Bio,
+		author.Website,
+		author.OpenLibraryId,
+		author.WikidataId,
+		author.PhotoKey,
 	).Scan(
 		&author.Id,
 		&author.CreatedAt,
@@ -48,9 +55,12 @@ func (repository *AuthorRepositoryImpl) Save(ctx context.Context, author domain.
 }
 
 func (repository *AuthorRepositoryImpl) CheckByFullName(ctx context.Context, fullName string) error {
+	// An alias takes a name just like the full_name of an author does
 	sqlQuery := `
-	SELECT 1 FROM authors 
-	WHERE full_name = $1
+	SELECT 1 FROM authors WHERE full_name = $1
+	UNION ALL
+	SELECT 1 FROM author_aliases WHERE name = $1
+	LIMIT 1
 	`
 
 	var exists int
@@ -69,9 +79,104 @@ func (repository *AuthorRepositoryImpl) CheckByFullName(ctx context.Context, ful
 	return nil
 }
 
-func (repository *AuthorRepositoryImpl) FindAll(ctx context.Context, fullName, nationality string) ([]domain.Author, error) {
+// CheckByExternalId tells if an author other than authorId already has the Open Library or Wikidata ID,
+// column is either open_library_id or wikidata_id
+func (repository *AuthorRepositoryImpl) CheckByExternalId(ctx context.Context, authorId, column, externalId string) error {
+	if column != "open_library_id" && column != "wikidata_id" {
+		return fmt.Errorf("unknown external id column %s", column)
+	}
+
+	sqlQuery := fmt.Sprintf(`
+	SELECT 1 FROM authors WHERE %s = $1 AND id::text <> $2
+	`, column)
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, externalId, authorId).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("author with %v '%v' is already exists", column, externalId)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// CheckByPhotoKey returns an error when an author other than authorId has the portrait, an empty authorId checks
+// every author
+func (repository *AuthorRepositoryImpl) CheckByPhotoKey(ctx context.Context, authorId, photoKey string) error {
+	sqlQuery := `
+	SELECT 1 FROM authors WHERE photo_key = $1 AND id::text <> $2
+	`
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, photoKey, authorId).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("author with photo_key '%v' is already exists", photoKey)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (repository *AuthorRepositoryImpl) FindByFullName(ctx context.Context, fullName string) (domain.Author, error) {
+	sqlQuery := `
+	SELECT id, full_name, nationality, COALESCE(TO_CHAR(birth_date, 'YYYY-MM-DD'), ''), COALESCE(TO_CHAR(death_date, 'YYYY-MM-DD'), ''),
+	       bio, website, open_library_id, wikidata_id, photo_key, created_at, updated_at
+	FROM authors
+	WHERE full_name = $1
+	`
+
+	var author domain.Author
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, fullName).Scan(
+		&author.Id,
+		&author.FullName,
+		&author.Nationality,
+		&author.BirthDate,
+		&author.DeathDate,
+		&author.Bio,
+		&author.Website,
+		&author.OpenLibraryId,
+		&author.WikidataId,
+		&author.PhotoKey,
+		&author.CreatedAt,
+		&author.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Author{}, err
+	}
+
+	return author, nil
+}
+
+// authorSortColumns maps the sort query param to an ORDER BY clause,
+// id is the tie breaker so pages are stable
+var authorSortColumns = map[string]string{
+	"name":        "full_name ASC, id ASC",
+	"-name":       "full_name DESC, id DESC",
+	"created_at":  "created_at ASC, id ASC",
+	"-created_at": "created_at DESC, id DESC",
+}
+
+func (repository *AuthorRepositoryImpl) FindAll(ctx context.Context, fullName, nationality string, page domain.Page) ([]domain.Author, int, error) {
 	baseQuery := `
-	SELECT id, full_name, nationality, created_at, updated_at
+	SELECT id, full_name, nationality, COALESCE(TO_CHAR(birth_date, 'YYYY-MM-DD'), ''), COALESCE(TO_CHAR(death_date, 'YYYY-MM-DD'), ''),
+	       bio, website, open_library_id, wikidata_id, photo_key, created_at, updated_at
+	FROM authors
+	`
+	countQuery := `
+	SELECT COUNT(*)
 	FROM authors
 	`
 
@@ -81,24 +186,43 @@ func (repository *AuthorRepositoryImpl) FindAll(ctx context.Context, fullName, n
 	argCount := 1
 
 	if fullName != "" {
-		conditions = append(conditions, fmt.Sprintf("full_name ILIKE $%d", argCount))
+		// An author also matches through any of their aliases, accents are ignored on both sides
+		conditions = append(conditions, fmt.Sprintf(`(immutable_unaccent(full_name) ILIKE immutable_unaccent($%[1]d) OR EXISTS (
+			SELECT 1 FROM author_aliases al WHERE al.author_id = authors.id AND immutable_unaccent(al.name) ILIKE immutable_unaccent($%[1]d)
+		))`, argCount))
 		args = append(args, "%"+fullName+"%")
 		argCount++
 	}
 	if nationality != "" {
-		conditions = append(conditions, fmt.Sprintf("nationality ILIKE $%d", argCount))
+		conditions = append(conditions, fmt.Sprintf("immutable_unaccent(nationality) ILIKE immutable_unaccent($%d)", argCount))
 		args = append(args, "%"+nationality+"%")
 		argCount++
 	}
 
-	sqlQuery := baseQuery
+	whereClause := ""
 	if len(conditions) > 0 {
-		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, countQuery+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := authorSortColumns[page.Sort]
+	if !ok {
+		orderBy = authorSortColumns["name"]
 	}
 
+	sqlQuery := baseQuery + whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, page.Limit, page.Offset)
+
 	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -111,27 +235,35 @@ func (repository *AuthorRepositoryImpl) FindAll(ctx context.Context, fullName, n
 			&author.Id,
 			&author.FullName,
 			&author.Nationality,
+			&author.BirthDate,
+			&author.DeathDate,
+			&author.Bio,
+			&author.Website,
+			&author.OpenLibraryId,
+			&author.WikidataId,
+			&author.PhotoKey,
 			&author.CreatedAt,
 			&author.UpdatedAt,
 		)
 
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		authors = append(authors, author)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return authors, nil
+	return authors, total, nil
 }
 
 func (repository *AuthorRepositoryImpl) FindById(ctx context.Context, authorId string) (domain.Author, error) {
 	sqlQuery := `
-	SELECT full_name, nationality, created_at, updated_at
+	SELECT full_name, nationality, COALESCE(TO_CHAR(birth_date, 'YYYY-MM-DD'), ''), COALESCE(TO_CHAR(death_date, 'YYYY-MM-DD'), ''),
+	       bio, website, open_library_id, wikidata_id, photo_key, created_at, updated_at
 	FROM authors
 	WHERE id = $1
 	`
@@ -143,6 +275,13 @@ func (repository *AuthorRepositoryImpl) FindById(ctx context.Context, authorId s
 	err := repository.DB.QueryRow(ctx, sqlQuery, authorId).Scan(
 		&author.FullName,
 		&author.Nationality,
+		&author.BirthDate,
+		&author.DeathDate,
+		&author.Bio,
+		&author.Website,
+		&author.OpenLibraryId,
+		&author.WikidataId,
+		&author.PhotoKey,
 		&author.CreatedAt,
 		&author.UpdatedAt,
 	)
@@ -156,8 +295,9 @@ func (repository *AuthorRepositoryImpl) FindById(ctx context.Context, authorId s
 func (repository *AuthorRepositoryImpl) Update(ctx context.Context, authorId string, author domain.Author) (domain.Author, error) {
 	sqlQuery := `
 	UPDATE authors
-	SET full_name = $1, nationality = $2, updated_at = $3
-	WHERE id = $4
+	SET full_name = $1, nationality = $2, birth_date = NULLIF($3, '')::date, death_date = NULLIF($4, '')::date,
+		bio = $5, website = $6, open_library_id = $7, wikidata_id = $8, photo_key = $9, updated_at = $10
+	WHERE id = $11
 	RETURNING created_at
 	`
 
@@ -168,6 +308,13 @@ func (repository *AuthorRepositoryImpl) Update(ctx context.Context, authorId str
 		sqlQuery,
 		author.FullName,
 		author.Nationality,
+		author.BirthDate,
+		author.DeathDate,
+		author.Bio,
+		author.Website,
+		author.OpenLibraryId,
+		author.WikidataId,
+		author.PhotoKey,
 		updatedAt,
 		authorId,
 	).Scan(
@@ -8,9 +8,21 @@ import (
 
 type BookRepository interface {
 	Save(ctx context.Context, book domain.Book) (domain.Book, error)
-	CheckByNameAndAuthorId(ctx context.Context, name, authorId string) error
-	FindAll(ctx context.Context, name, status, author_name string) ([]domain.Book, error)
-	FindById(ctx context.Context, bookId string) (domain.Book, error)
-	Update(ctx context.Context, bookId string, book domain.Book) (domain.Book, error)
-	Delete(ctx context.Context, bookId string) error
+	CheckByNameAndAuthorId(ctx context.Context, ownerId, name, authorId string) error
+	FindAll(ctx context.Context, ownerId string, filter domain.BookFilter, page domain.Page) ([]domain.Book, int, error)
+	FindById(ctx context.Context, ownerId, bookId string) (domain.Book, error)
+	Update(ctx context.Context, ownerId, bookId string, book domain.Book) (domain.Book, error)
+	UpdateProgress(ctx context.Context, ownerId, bookId string, book domain.Book) error
+	Delete(ctx context.Context, ownerId, bookId string) error
+	FindAllBySeriesId(ctx context.Context, ownerId, seriesId string) ([]domain.Book, error)
+	FindNextUnreadInSeries(ctx context.Context, ownerId, seriesId string) (domain.Book, error)
+	FindBestMatch(ctx context.Context, ownerId, title, authorName string) (domain.Book, error)
+	StreamAllWithAuthor(ctx context.Context, ownerId string, fn func(book domain.BookWithAuthor) error) error
+	FindIdsByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error)
+	CountOfOtherOwnersByAuthorId(ctx context.Context, ownerId, authorId string) (int, error)
+	DeleteAllByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error)
+	ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error
+	MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error
+	FindCollisionsByAuthorIds(ctx context.Context, authorIds []string) ([]domain.BookCollision, error)
+	MergeBooks(ctx context.Context, bookId string, mergedIds []string) ([]string, error)
 }
@@ -24,21 +24,31 @@ type BookRepositoryImpl struct {
 
 func (repository *BookRepositoryImpl) Save(ctx context.Context, book domain.Book) (domain.Book, error) {
 	sqlQuery := `
-	INSERT INTO books (id, name, total_page, author_id, photo_key, status, completed_date)
-	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	INSERT INTO books (id, owner_id, name, total_page, current_page, photo_key, status, completed_date,
+	                   isbn, publisher, publication_year, language, series_id, series_position)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, '')::date, $9, $10, $11, $12, $13, $14)
 	RETURNING id, created_at, updated_at
 	`
 
+	seriesId, seriesPosition := seriesArgs(book)
+
 	err := repository.DB.QueryRow(
 		ctx,
 		sqlQuery,
 		uuid.NewString(),
+		book.OwnerId,
 		book.Name,
 		book.TotalPage,
-		book.AuthorId,
+		book.CurrentPage,
 		book.PhotoKey,
 		book.Status,
 		book.CompletedDate,
+		book.Isbn,
+		book.Publisher,
+		book.PublicationYear,
+		book.Language,
+		seriesId,
+		seriesPosition,
 	).Scan(
 		&book.Id,
 		&book.CreatedAt,
@@ -48,17 +58,24 @@ func (repository *BookRepositoryImpl) Save(ctx context.Context, book domain.Book
 		return domain.Book{}, err
 	}
 
+	err = repository.saveContributors(ctx, book.Id, book.Contributors)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
 	return book, nil
 }
 
-func (repository *BookRepositoryImpl) CheckByNameAndAuthorId(ctx context.Context, name, authorId string) error {
+func (repository *BookRepositoryImpl) CheckByNameAndAuthorId(ctx context.Context, ownerId, name, authorId string) error {
 	sqlQuery := `
-	SELECT 1 FROM books
-	WHERE name = $1 AND author_id = $2
+	SELECT 1 FROM books b
+	WHERE b.owner_id = $1 AND b.name = $2
+	  AND EXISTS (SELECT 1 FROM book_contributors bc WHERE bc.book_id = b.id AND bc.author_id = $3)
+	LIMIT 1
 	`
 
 	var exists int
-	err := repository.DB.QueryRow(ctx, sqlQuery, name, authorId).Scan(&exists)
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, name, authorId).Scan(&exists)
 	if exists == 1 {
 		return fmt.Errorf("book with name %v and author_id '%v' is already exists", name, authorId)
 	}
@@ -70,97 +87,224 @@ func (repository *BookRepositoryImpl) CheckByNameAndAuthorId(ctx context.Context
 	return err
 }
 
-func (repository *BookRepositoryImpl) FindAll(ctx context.Context, name, status, author_name string) ([]domain.Book, error) {
+// bookSortColumns maps the sort query param to an ORDER BY clause,
+// b.id is the tie breaker so pages are stable
+var bookSortColumns = map[string]string{
+	"name":        "b.name ASC, b.id ASC",
+	"-name":       "b.name DESC, b.id DESC",
+	"created_at":  "b.created_at ASC, b.id ASC",
+	"-created_at": "b.created_at DESC, b.id DESC",
+	"total_page":  "b.total_page ASC, b.id ASC",
+	"-total_page": "b.total_page DESC, b.id DESC",
+	"rating":      "r.rating ASC NULLS LAST, b.id ASC",
+	"-rating":     "r.rating DESC NULLS LAST, b.id DESC",
+}
+
+func (repository *BookRepositoryImpl) FindAll(ctx context.Context, ownerId string, filter domain.BookFilter, page domain.Page) ([]domain.Book, int, error) {
 	baseQuery := `
-	SELECT b.id, b.name, b.total_page, b.author_id, b.photo_key,
-       	   b.status, b.completed_date, b.created_at, b.updated_at
+	SELECT b.id, b.name, b.total_page, b.current_page, b.photo_key,
+       	   b.status, COALESCE(TO_CHAR(b.completed_date, 'YYYY-MM-DD'), ''), b.isbn, b.publisher, b.publication_year, b.language,
+       	   COALESCE(b.series_id::text, ''), COALESCE(b.series_position, 0)::float8,
+       	   COALESCE(r.rating, 0)::float8, b.created_at, b.updated_at
 	FROM books b
-	JOIN authors a ON b.author_id = a.id
+	LEFT JOIN reviews r ON r.book_id = b.id
+	`
+	countQuery := `
+	SELECT COUNT(*)
+	FROM books b
+	LEFT JOIN reviews r ON r.book_id = b.id
 	`
 
-	// Slice to aggregate arguments and WHERE condition dynamically
-	args := []interface{}{}
-	conditions := []string{}
-	argCount := 1
+	// Slice to aggregate arguments and WHERE condition dynamically,
+	// books are always scoped to their owner
+	args := []interface{}{ownerId}
+	conditions := []string{"b.owner_id = $1"}
+	argCount := 2
 
-	if name != "" {
+	if filter.Name != "" {
 		conditions = append(conditions, fmt.Sprintf("b.name ILIKE $%d", argCount))
-		args = append(args, "%"+name+"%")
+		args = append(args, "%"+filter.Name+"%")
 		argCount++
 	}
 
-	if status != "" {
+	if filter.Status != "" {
 		conditions = append(conditions, fmt.Sprintf("b.status = $%d", argCount))
-		args = append(args, status)
+		args = append(args, filter.Status)
+		argCount++
+	}
+
+	if filter.CompletedFrom != "" {
+		conditions = append(conditions, fmt.Sprintf("b.completed_date >= $%d::date", argCount))
+		args = append(args, filter.CompletedFrom)
+		argCount++
+	}
+
+	if filter.CompletedTo != "" {
+		conditions = append(conditions, fmt.Sprintf("b.completed_date <= $%d::date", argCount))
+		args = append(args, filter.CompletedTo)
 		argCount++
 	}
 
-	if author_name != "" {
-		conditions = append(conditions, fmt.Sprintf("a.full_name ILIKE $%d", argCount))
-		args = append(args, "%"+author_name+"%")
+	if filter.RatingMin != 0 {
+		conditions = append(conditions, fmt.Sprintf("r.rating >= $%d", argCount))
+		args = append(args, filter.RatingMin)
 		argCount++
 	}
 
-	sqlQuery := baseQuery
-	if len(conditions) > 0 {
-		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+	if filter.AuthorName != "" {
+		// Any contributor matches, not only the primary author, through their full_name or any of their aliases,
+		// accents are ignored on both sides
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM book_contributors bc
+			JOIN authors a ON bc.author_id = a.id
+			WHERE bc.book_id = b.id AND (immutable_unaccent(a.full_name) ILIKE immutable_unaccent($%[1]d) OR EXISTS (
+				SELECT 1 FROM author_aliases al WHERE al.author_id = a.id AND immutable_unaccent(al.name) ILIKE immutable_unaccent($%[1]d)
+			))
+		)`, argCount))
+		args = append(args, "%"+filter.AuthorName+"%")
+		argCount++
+	}
+
+	if len(filter.Tags) > 0 {
+		tags := uniqueLower(filter.Tags)
+
+		if filter.TagsMatch == domain.TagsMatchAny {
+			conditions = append(conditions, fmt.Sprintf(`EXISTS (
+				SELECT 1 FROM book_tags bt
+				JOIN tags t ON bt.tag_id = t.id
+				WHERE bt.book_id = b.id AND LOWER(t.name) = ANY($%d::text[])
+			)`, argCount))
+			args = append(args, tags)
+			argCount++
+		} else {
+			// A book matches every tag when it carries as many of the given tags as were asked for
+			conditions = append(conditions, fmt.Sprintf(`b.id IN (
+				SELECT bt.book_id FROM book_tags bt
+				JOIN tags t ON bt.tag_id = t.id
+				WHERE t.owner_id = $1 AND LOWER(t.name) = ANY($%d::text[])
+				GROUP BY bt.book_id
+				HAVING COUNT(*) = $%d
+			)`, argCount, argCount+1))
+			args = append(args, tags, len(tags))
+			argCount += 2
+		}
+	}
+
+	whereClause := " WHERE " + strings.Join(conditions, " AND ")
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, countQuery+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := bookSortColumns[page.Sort]
+	if !ok {
+		orderBy = bookSortColumns["-created_at"]
 	}
 
+	sqlQuery := baseQuery + whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, page.Limit, page.Offset)
+
 	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
 	books := make([]domain.Book, 0)
 
 	for rows.Next() {
-		var book domain.Book
+		book := domain.Book{
+			OwnerId: ownerId,
+		}
 
 		err := rows.Scan(
 			&book.Id,
 			&book.Name,
 			&book.TotalPage,
-			&book.AuthorId,
+			&book.CurrentPage,
 			&book.PhotoKey,
 			&book.Status,
 			&book.CompletedDate,
+			&book.Isbn,
+			&book.Publisher,
+			&book.PublicationYear,
+			&book.Language,
+			&book.SeriesId,
+			&book.SeriesPosition,
+			&book.Rating,
 			&book.CreatedAt,
 			&book.UpdatedAt,
 		)
 
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		books = append(books, book)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return books, nil
+	// Load the contributors of the whole page with a single query
+	bookIds := make([]string, 0, len(books))
+	for _, book := range books {
+		bookIds = append(bookIds, book.Id)
+	}
+
+	contributors, err := repository.findContributors(ctx, bookIds)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tags, err := repository.findTags(ctx, bookIds)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range books {
+		books[i].Contributors = contributors[books[i].Id]
+		books[i].AuthorId = primaryAuthorId(books[i].Contributors)
+		books[i].Tags = tags[books[i].Id]
+	}
+
+	return books, total, nil
 }
 
-func (repository *BookRepositoryImpl) FindById(ctx context.Context, bookId string) (domain.Book, error) {
+func (repository *BookRepositoryImpl) FindById(ctx context.Context, ownerId, bookId string) (domain.Book, error) {
 	sqlQuery := `
-	SELECT name, total_page, author_id, photo_key, status, completed_date, created_at, updated_at
+	SELECT name, total_page, current_page, photo_key, status, COALESCE(TO_CHAR(completed_date, 'YYYY-MM-DD'), ''),
+	       isbn, publisher, publication_year, language,
+	       COALESCE(series_id::text, ''), COALESCE(series_position, 0)::float8,
+	       COALESCE((SELECT rating FROM reviews WHERE book_id = books.id), 0)::float8, created_at, updated_at
 	FROM books
-	WHERE id = $1
+	WHERE id = $1 AND owner_id = $2
 	`
 
 	book := domain.Book{
-		Id: bookId,
+		Id:      bookId,
+		OwnerId: ownerId,
 	}
 
-	err := repository.DB.QueryRow(ctx, sqlQuery, bookId).Scan(
+	err := repository.DB.QueryRow(ctx, sqlQuery, bookId, ownerId).Scan(
 		&book.Name,
 		&book.TotalPage,
-		&book.AuthorId,
+		&book.CurrentPage,
 		&book.PhotoKey,
 		&book.Status,
 		&book.CompletedDate,
+		&book.Isbn,
+		&book.Publisher,
+		&book.PublicationYear,
+		&book.Language,
+		&book.SeriesId,
+		&book.SeriesPosition,
+		&book.Rating,
 		&book.CreatedAt,
 		&book.UpdatedAt,
 	)
@@ -168,30 +312,55 @@ func (repository *BookRepositoryImpl) FindById(ctx context.Context, bookId strin
 		return domain.Book{}, err
 	}
 
+	contributors, err := repository.findContributors(ctx, []string{bookId})
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	book.Contributors = contributors[bookId]
+	book.AuthorId = primaryAuthorId(book.Contributors)
+
+	tags, err := repository.findTags(ctx, []string{bookId})
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	book.Tags = tags[bookId]
+
 	return book, nil
 }
 
-func (repository *BookRepositoryImpl) Update(ctx context.Context, bookId string, book domain.Book) (domain.Book, error) {
+func (repository *BookRepositoryImpl) Update(ctx context.Context, ownerId, bookId string, book domain.Book) (domain.Book, error) {
 	sqlQuery := `
 	UPDATE books
-	SET name = $1, total_page = $2, author_id = $3, photo_key = $4, status = $5, completed_date = $6, updated_at = $7
-	WHERE id = $8
+	SET name = $1, total_page = $2, current_page = $3, photo_key = $4, status = $5, completed_date = NULLIF($6, '')::date,
+	    isbn = $7, publisher = $8, publication_year = $9, language = $10, series_id = $11, series_position = $12,
+	    updated_at = $13
+	WHERE id = $14 AND owner_id = $15
 	RETURNING created_at
 	`
 
 	updatedAt := time.Now()
+	seriesId, seriesPosition := seriesArgs(book)
 
 	err := repository.DB.QueryRow(
 		ctx,
 		sqlQuery,
 		book.Name,
 		book.TotalPage,
-		book.AuthorId,
+		book.CurrentPage,
 		book.PhotoKey,
 		book.Status,
 		book.CompletedDate,
+		book.Isbn,
+		book.Publisher,
+		book.PublicationYear,
+		book.Language,
+		seriesId,
+		seriesPosition,
 		updatedAt,
 		bookId,
+		ownerId,
 	).Scan(
 		&book.CreatedAt,
 	)
@@ -199,19 +368,691 @@ func (repository *BookRepositoryImpl) Update(ctx context.Context, bookId string,
 		return domain.Book{}, err
 	}
 
+	err = repository.saveContributors(ctx, bookId, book.Contributors)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
 	return book, nil
 }
 
-func (repository *BookRepositoryImpl) Delete(ctx context.Context, bookId string) error {
+func (repository *BookRepositoryImpl) UpdateProgress(ctx context.Context, ownerId, bookId string, book domain.Book) error {
+	sqlQuery := `
+	UPDATE books
+	SET current_page = $1, status = $2, completed_date = NULLIF($3, '')::date, updated_at = $4
+	WHERE id = $5 AND owner_id = $6
+	`
+
+	_, err := repository.DB.Exec(
+		ctx,
+		sqlQuery,
+		book.CurrentPage,
+		book.Status,
+		book.CompletedDate,
+		time.Now(),
+		bookId,
+		ownerId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repository *BookRepositoryImpl) Delete(ctx context.Context, ownerId, bookId string) error {
 	sqlQuery := `
 	DELETE FROM books
-	WHERE id = $1
+	WHERE id = $1 AND owner_id = $2
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, bookId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindIdsByAuthorId returns the books of the owner that list the author as a contributor
+func (repository *BookRepositoryImpl) FindIdsByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error) {
+	sqlQuery := `
+	SELECT DISTINCT bc.book_id::text
+	FROM book_contributors bc
+	JOIN books b ON b.id = bc.book_id
+	WHERE b.owner_id = $1 AND bc.author_id = $2
+	ORDER BY bc.book_id::text ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, authorId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bookIds := make([]string, 0)
+
+	for rows.Next() {
+		var bookId string
+
+		err := rows.Scan(&bookId)
+		if err != nil {
+			return nil, err
+		}
+
+		bookIds = append(bookIds, bookId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bookIds, nil
+}
+
+// CountOfOtherOwnersByAuthorId counts the books of owners other than ownerId that list the author as a contributor,
+// the books themselves are not returned since they belong to somebody else
+func (repository *BookRepositoryImpl) CountOfOtherOwnersByAuthorId(ctx context.Context, ownerId, authorId string) (int, error) {
+	sqlQuery := `
+	SELECT COUNT(DISTINCT bc.book_id)
+	FROM book_contributors bc
+	JOIN books b ON b.id = bc.book_id
+	WHERE b.owner_id <> $1 AND bc.author_id = $2
+	`
+
+	var total int
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, authorId).Scan(&total)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// DeleteAllByAuthorId deletes the books of the owner that only the author contributed to. The author is taken off
+// the books of the owner that have other contributors, those books are kept. It returns the photo keys of the deleted books
+func (repository *BookRepositoryImpl) DeleteAllByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error) {
+	sqlQuery := `
+	DELETE FROM books b
+	WHERE b.owner_id = $1
+	  AND EXISTS (SELECT 1 FROM book_contributors bc WHERE bc.book_id = b.id AND bc.author_id = $2)
+	  AND NOT EXISTS (SELECT 1 FROM book_contributors bc WHERE bc.book_id = b.id AND bc.author_id <> $2)
+	RETURNING COALESCE(b.photo_key, '')
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, authorId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photoKeys := make([]string, 0)
+
+	for rows.Next() {
+		var photoKey string
+
+		err := rows.Scan(&photoKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if photoKey != "" {
+			photoKeys = append(photoKeys, photoKey)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	_, err = repository.DB.Exec(ctx, `
+	DELETE FROM book_contributors bc
+	USING books b
+	WHERE bc.book_id = b.id AND b.owner_id = $1 AND bc.author_id = $2
+	`, ownerId, authorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return photoKeys, nil
+}
+
+// ReassignAuthor moves the contributions of an author to another author on the books of the owner.
+// When both already contribute to a book with the same role, only the one listed first is kept
+func (repository *BookRepositoryImpl) ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error {
+	sqlQuery := `
+	DELETE FROM book_contributors d
+	USING book_contributors k, books b
+	WHERE d.book_id = k.book_id AND d.role = k.role AND d.position > k.position
+	  AND ((d.author_id = $1 AND k.author_id = $2) OR (d.author_id = $2 AND k.author_id = $1))
+	  AND b.id = d.book_id AND b.owner_id = $3
 	`
 
-	_, err := repository.DB.Exec(ctx, sqlQuery, bookId)
+	_, err := repository.DB.Exec(ctx, sqlQuery, fromAuthorId, toAuthorId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	sqlQuery = `
+	UPDATE book_contributors bc
+	SET author_id = $2
+	FROM books b
+	WHERE bc.book_id = b.id AND b.owner_id = $3 AND bc.author_id = $1
+	`
+
+	_, err = repository.DB.Exec(ctx, sqlQuery, fromAuthorId, toAuthorId, ownerId)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// MergeAuthor moves every contribution of an author to another author on the books of every owner, the author
+// is a duplicate of the other one in the shared catalog. When both already contribute to a book with the same role,
+// only the one listed first is kept
+func (repository *BookRepositoryImpl) MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	sqlQuery := `
+	DELETE FROM book_contributors d
+	USING book_contributors k
+	WHERE d.book_id = k.book_id AND d.role = k.role AND d.position > k.position
+	  AND ((d.author_id = $1 AND k.author_id = $2) OR (d.author_id = $2 AND k.author_id = $1))
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, fromAuthorId, toAuthorId)
+	if err != nil {
+		return err
+	}
+
+	sqlQuery = `
+	UPDATE book_contributors
+	SET author_id = $2
+	WHERE author_id = $1
+	`
+
+	_, err = repository.DB.Exec(ctx, sqlQuery, fromAuthorId, toAuthorId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindCollisionsByAuthorIds finds the books of every owner with the same name and different authors among authorIds,
+// they are the books CheckByNameAndAuthorId would flag once the authors are merged into one. The books of a collision
+// are ordered by when they were added, the first added comes first
+func (repository *BookRepositoryImpl) FindCollisionsByAuthorIds(ctx context.Context, authorIds []string) ([]domain.BookCollision, error) {
+	sqlQuery := `
+	WITH collisions AS (
+		SELECT b.owner_id, b.name
+		FROM books b
+		JOIN book_contributors bc ON bc.book_id = b.id
+		WHERE bc.author_id = ANY($1::text[]::uuid[])
+		GROUP BY b.owner_id, b.name
+		HAVING COUNT(DISTINCT b.id) > 1 AND COUNT(DISTINCT bc.author_id) > 1
+	)
+	SELECT c.owner_id::text, c.name, array_agg(b.id::text ORDER BY b.created_at ASC, b.id::text ASC)
+	FROM collisions c
+	JOIN books b ON b.owner_id = c.owner_id AND b.name = c.name
+	WHERE EXISTS (SELECT 1 FROM book_contributors bc WHERE bc.book_id = b.id AND bc.author_id = ANY($1::text[]::uuid[]))
+	GROUP BY c.owner_id, c.name
+	ORDER BY c.owner_id ASC, c.name ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collisions := make([]domain.BookCollision, 0)
+
+	for rows.Next() {
+		var collision domain.BookCollision
+
+		err := rows.Scan(
+			&collision.OwnerId,
+			&collision.Name,
+			&collision.BookIds,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		collisions = append(collisions, collision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return collisions, nil
+}
+
+// MergeBooks moves the reads, reading sessions, notes, highlights, tags and reviews of the merged books onto the book
+// and deletes the merged books. The book keeps its own review, the reviews of the merged books become its revisions,
+// and it keeps one read in progress. It returns the photo keys of the deleted books
+func (repository *BookRepositoryImpl) MergeBooks(ctx context.Context, bookId string, mergedIds []string) ([]string, error) {
+	sqlQueries := []string{
+		// The book is as far along as the furthest of them
+		`UPDATE books
+		SET current_page = LEAST(total_page, GREATEST(current_page, (SELECT COALESCE(MAX(m.current_page), 0) FROM books m WHERE m.id = ANY($2::text[]::uuid[]))))
+		WHERE id = $1`,
+		`UPDATE reading_sessions SET book_id = $1 WHERE book_id = ANY($2::text[]::uuid[])`,
+		`UPDATE notes SET book_id = $1 WHERE book_id = ANY($2::text[]::uuid[])`,
+		// A clipping imported for more than one of the books is kept once
+		`DELETE FROM highlights d
+		USING highlights k
+		WHERE d.book_id = ANY($2::text[]::uuid[]) AND k.fingerprint = d.fingerprint
+		  AND (k.book_id = $1 OR (k.book_id = ANY($2::text[]::uuid[]) AND k.id::text < d.id::text))`,
+		`UPDATE highlights SET book_id = $1 WHERE book_id = ANY($2::text[]::uuid[])`,
+		`INSERT INTO book_tags (book_id, tag_id, created_at)
+		SELECT $1::uuid, tag_id, MIN(created_at) FROM book_tags WHERE book_id = ANY($2::text[]::uuid[]) GROUP BY tag_id
+		ON CONFLICT DO NOTHING`,
+		// A book has at most one read in progress, the one of the book or else the first started is kept
+		`DELETE FROM reads d
+		WHERE d.outcome IS NULL AND d.book_id = ANY($2::text[]::uuid[])
+		  AND d.id <> (
+			SELECT r.id FROM reads r
+			WHERE r.outcome IS NULL AND (r.book_id = $1 OR r.book_id = ANY($2::text[]::uuid[]))
+			ORDER BY r.book_id = $1 DESC, r.started_date ASC, r.id ASC
+			LIMIT 1
+		  )`,
+		`UPDATE reads SET book_id = $1 WHERE book_id = ANY($2::text[]::uuid[])`,
+		// The latest review of the merged books becomes the review of a book without one
+		`UPDATE reviews SET book_id = $1
+		WHERE id = (SELECT id FROM reviews WHERE book_id = ANY($2::text[]::uuid[]) ORDER BY updated_at DESC, id ASC LIMIT 1)
+		  AND NOT EXISTS (SELECT 1 FROM reviews WHERE book_id = $1)`,
+		`UPDATE review_revisions rr SET review_id = k.id
+		FROM reviews r, reviews k
+		WHERE rr.review_id = r.id AND r.book_id = ANY($2::text[]::uuid[]) AND k.book_id = $1`,
+		`INSERT INTO review_revisions (id, review_id, rating, body, created_at)
+		SELECT gen_random_uuid(), k.id, r.rating, r.body, r.updated_at
+		FROM reviews r
+		JOIN reviews k ON k.book_id = $1
+		WHERE r.book_id = ANY($2::text[]::uuid[])`,
+	}
+
+	for _, sqlQuery := range sqlQueries {
+		_, err := repository.DB.Exec(ctx, sqlQuery, bookId, mergedIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A cover the book still uses is not returned
+	sqlQuery := `
+	DELETE FROM books
+	WHERE id = ANY($2::text[]::uuid[])
+	RETURNING COALESCE(NULLIF(photo_key, (SELECT photo_key FROM books WHERE id = $1)), '')
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, bookId, mergedIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photoKeys := make([]string, 0)
+
+	for rows.Next() {
+		var photoKey string
+
+		err := rows.Scan(&photoKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if photoKey != "" {
+			photoKeys = append(photoKeys, photoKey)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return photoKeys, nil
+}
+
+// StreamAllWithAuthor calls fn for every book of the owner while the rows are still being read,
+// so the whole library is never held in memory. The author is the primary author of the book
+func (repository *BookRepositoryImpl) StreamAllWithAuthor(ctx context.Context, ownerId string, fn func(book domain.BookWithAuthor) error) error {
+	sqlQuery := `
+	SELECT b.id, b.name, b.total_page, b.current_page, a.id, a.full_name, a.nationality,
+	       b.status, COALESCE(TO_CHAR(b.completed_date, 'YYYY-MM-DD'), ''), b.isbn, b.publisher, b.publication_year, b.language,
+	       b.created_at, b.updated_at
+	FROM books b
+	JOIN LATERAL (
+		SELECT a.id, a.full_name, a.nationality
+		FROM book_contributors bc
+		JOIN authors a ON bc.author_id = a.id
+		WHERE bc.book_id = b.id
+		ORDER BY bc.position ASC
+		LIMIT 1
+	) a ON true
+	WHERE b.owner_id = $1
+	ORDER BY b.created_at ASC, b.id ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var book domain.BookWithAuthor
+
+		err := rows.Scan(
+			&book.Id,
+			&book.Name,
+			&book.TotalPage,
+			&book.CurrentPage,
+			&book.AuthorId,
+			&book.AuthorName,
+			&book.AuthorNationality,
+			&book.Status,
+			&book.CompletedDate,
+			&book.Isbn,
+			&book.Publisher,
+			&book.PublicationYear,
+			&book.Language,
+			&book.CreatedAt,
+			&book.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(book); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// saveContributors replaces the contributors of a book, the slice order becomes their position
+func (repository *BookRepositoryImpl) saveContributors(ctx context.Context, bookId string, contributors []domain.BookContributor) error {
+	_, err := repository.DB.Exec(ctx, "DELETE FROM book_contributors WHERE book_id = $1", bookId)
+	if err != nil {
+		return err
+	}
+
+	authorIds := make([]string, 0, len(contributors))
+	roles := make([]string, 0, len(contributors))
+	positions := make([]int, 0, len(contributors))
+	aliasIds := make([]string, 0, len(contributors))
+	for i, contributor := range contributors {
+		authorIds = append(authorIds, contributor.AuthorId)
+		roles = append(roles, contributor.Role)
+		positions = append(positions, i)
+		aliasIds = append(aliasIds, contributor.AliasId)
+	}
+
+	sqlQuery := `
+	INSERT INTO book_contributors (book_id, author_id, role, position, alias_id)
+	SELECT $1, c.author_id::uuid, c.role::contributor_role, c.position, NULLIF(c.alias_id, '')::uuid
+	FROM unnest($2::text[], $3::text[], $4::int[], $5::text[]) AS c(author_id, role, position, alias_id)
+	`
+
+	_, err = repository.DB.Exec(ctx, sqlQuery, bookId, authorIds, roles, positions, aliasIds)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// findContributors returns the contributors of every given book keyed by book id, in position order
+func (repository *BookRepositoryImpl) findContributors(ctx context.Context, bookIds []string) (map[string][]domain.BookContributor, error) {
+	sqlQuery := `
+	SELECT bc.book_id, bc.author_id, a.full_name, bc.role, bc.position, COALESCE(bc.alias_id::text, ''), COALESCE(al.name, '')
+	FROM book_contributors bc
+	JOIN authors a ON bc.author_id = a.id
+	LEFT JOIN author_aliases al ON bc.alias_id = al.id
+	WHERE bc.book_id = ANY($1::text[]::uuid[])
+	ORDER BY bc.book_id, bc.position ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, bookIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contributors := make(map[string][]domain.BookContributor, len(bookIds))
+
+	for rows.Next() {
+		var bookId string
+		var contributor domain.BookContributor
+
+		err := rows.Scan(
+			&bookId,
+			&contributor.AuthorId,
+			&contributor.FullName,
+			&contributor.Role,
+			&contributor.Position,
+			&contributor.AliasId,
+			&contributor.PublishedAs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		contributors[bookId] = append(contributors[bookId], contributor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contributors, nil
+}
+
+// findTags returns the tags of every given book keyed by book id, in name order
+func (repository *BookRepositoryImpl) findTags(ctx context.Context, bookIds []string) (map[string][]domain.Tag, error) {
+	sqlQuery := `
+	SELECT bt.book_id, t.id, t.owner_id, t.name, t.created_at, t.updated_at
+	FROM book_tags bt
+	JOIN tags t ON bt.tag_id = t.id
+	WHERE bt.book_id = ANY($1::text[]::uuid[])
+	ORDER BY bt.book_id, LOWER(t.name) ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, bookIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]domain.Tag, len(bookIds))
+
+	for rows.Next() {
+		var bookId string
+		var tag domain.Tag
+
+		err := rows.Scan(
+			&bookId,
+			&tag.Id,
+			&tag.OwnerId,
+			&tag.Name,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tags[bookId] = append(tags[bookId], tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// seriesArgs returns the series columns of a book, both are NULL for a book outside of a series
+func seriesArgs(book domain.Book) (interface{}, interface{}) {
+	if book.SeriesId == "" {
+		return nil, nil
+	}
+
+	return book.SeriesId, book.SeriesPosition
+}
+
+// uniqueLower lowercases values and drops the duplicates, keeping their order
+func uniqueLower(values []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(value)
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		unique = append(unique, value)
+	}
+
+	return unique
+}
+
+func primaryAuthorId(contributors []domain.BookContributor) string {
+	if len(contributors) == 0 {
+		return ""
+	}
+
+	return contributors[0].AuthorId
+}
+
+// FindAllBySeriesId returns the owner's volumes of a series in reading order
+func (repository *BookRepositoryImpl) FindAllBySeriesId(ctx context.Context, ownerId, seriesId string) ([]domain.Book, error) {
+	sqlQuery := `
+	SELECT id, name, total_page, current_page, status, COALESCE(TO_CHAR(completed_date, 'YYYY-MM-DD'), ''), series_position::float8, created_at, updated_at
+	FROM books
+	WHERE series_id = $1 AND owner_id = $2
+	ORDER BY series_position ASC, name ASC, id ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, seriesId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]domain.Book, 0)
+
+	for rows.Next() {
+		book := domain.Book{
+			OwnerId:  ownerId,
+			SeriesId: seriesId,
+		}
+
+		err := rows.Scan(
+			&book.Id,
+			&book.Name,
+			&book.TotalPage,
+			&book.CurrentPage,
+			&book.Status,
+			&book.CompletedDate,
+			&book.SeriesPosition,
+			&book.CreatedAt,
+			&book.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		books = append(books, book)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
+
+// Thresholds of FindBestMatch, a title matches on its own or as part of a longer title such as one with a subtitle
+const (
+	bookMatchTitleThreshold  = 0.5
+	bookMatchAuthorThreshold = 0.3
+)
+
+// FindBestMatch finds the book of the owner whose name and author are most similar to a title and author name
+// written elsewhere, such as in e-reader clippings. The author is not compared when authorName is empty
+func (repository *BookRepositoryImpl) FindBestMatch(ctx context.Context, ownerId, title, authorName string) (domain.Book, error) {
+	sqlQuery := `
+	SELECT id, name, author_id
+	FROM (
+		SELECT b.id, b.name, a.id AS author_id,
+		       GREATEST(similarity(b.name, $2), word_similarity(b.name, $2)) AS title_score,
+		       similarity(a.full_name, $3) AS author_score
+		FROM books b
+		JOIN LATERAL (
+			SELECT a.id, a.full_name
+			FROM book_contributors bc
+			JOIN authors a ON bc.author_id = a.id
+			WHERE bc.book_id = b.id
+			ORDER BY bc.position ASC
+			LIMIT 1
+		) a ON true
+		WHERE b.owner_id = $1
+	) candidates
+	WHERE title_score >= $4 AND ($3 = '' OR author_score >= $5)
+	ORDER BY title_score * 0.8 + author_score * 0.2 DESC, id ASC
+	LIMIT 1
+	`
+
+	book := domain.Book{
+		OwnerId: ownerId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, title, authorName, bookMatchTitleThreshold, bookMatchAuthorThreshold).Scan(
+		&book.Id,
+		&book.Name,
+		&book.AuthorId,
+	)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return book, nil
+}
+
+// FindNextUnreadInSeries returns the first volume of a series in reading order that the owner has not completed
+func (repository *BookRepositoryImpl) FindNextUnreadInSeries(ctx context.Context, ownerId, seriesId string) (domain.Book, error) {
+	sqlQuery := `
+	SELECT id, name, total_page, current_page, status, COALESCE(TO_CHAR(completed_date, 'YYYY-MM-DD'), ''), series_position::float8, created_at, updated_at
+	FROM books
+	WHERE series_id = $1 AND owner_id = $2 AND status <> 'completed'
+	ORDER BY series_position ASC, name ASC, id ASC
+	LIMIT 1
+	`
+
+	book := domain.Book{
+		OwnerId:  ownerId,
+		SeriesId: seriesId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, seriesId, ownerId).Scan(
+		&book.Id,
+		&book.Name,
+		&book.TotalPage,
+		&book.CurrentPage,
+		&book.Status,
+		&book.CompletedDate,
+		&book.SeriesPosition,
+		&book.CreatedAt,
+		&book.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return book, nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type GoalRepository interface {
+	Save(ctx context.Context, goal domain.Goal) (domain.Goal, error)
+	CheckByPeriod(ctx context.Context, ownerId, metric, period string, startDate time.Time) error
+	FindAll(ctx context.Context, ownerId string, filter domain.GoalFilter, page domain.Page) ([]domain.Goal, int, error)
+	FindById(ctx context.Context, ownerId, goalId string) (domain.Goal, error)
+	Update(ctx context.Context, goal domain.Goal) (domain.Goal, error)
+	Delete(ctx context.Context, ownerId, goalId string) error
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewGoalRepository(db PgxDBTX) GoalRepository {
+	return &GoalRepositoryImpl{
+		DB: db,
+	}
+}
+
+type GoalRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *GoalRepositoryImpl) Save(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	sqlQuery := `
+	INSERT INTO goals (id, owner_id, metric, period, target, start_date, end_date)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		goal.OwnerId,
+		goal.Metric,
+		goal.Period,
+		goal.Target,
+		goal.StartDate,
+		goal.EndDate,
+	).Scan(
+		&goal.Id,
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (repository *GoalRepositoryImpl) CheckByPeriod(ctx context.Context, ownerId, metric, period string, startDate time.Time) error {
+	sqlQuery := `
+	SELECT 1 FROM goals
+	WHERE owner_id = $1 AND metric = $2 AND period = $3 AND start_date = $4
+	LIMIT 1
+	`
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, metric, period, startDate).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("%s goal for the %s starting at %s is already exists", metric, period, startDate.Format("2006-01-02"))
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+	}
+	return err
+}
+
+// goalSortColumns maps the sort query param to an ORDER BY clause,
+// id is the tie breaker so pages are stable
+var goalSortColumns = map[string]string{
+	"start_date":  "start_date ASC, metric ASC, id ASC",
+	"-start_date": "start_date DESC, metric ASC, id DESC",
+	"created_at":  "created_at ASC, id ASC",
+	"-created_at": "created_at DESC, id DESC",
+}
+
+func (repository *GoalRepositoryImpl) FindAll(ctx context.Context, ownerId string, filter domain.GoalFilter, page domain.Page) ([]domain.Goal, int, error) {
+	whereClause := " WHERE owner_id = $1"
+	args := []interface{}{ownerId}
+	if filter.Metric != "" {
+		args = append(args, filter.Metric)
+		whereClause += fmt.Sprintf(" AND metric = $%d", len(args))
+	}
+	if filter.Year != 0 {
+		args = append(args, filter.Year)
+		whereClause += fmt.Sprintf(" AND EXTRACT(YEAR FROM start_date) = $%d", len(args))
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM goals"+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := goalSortColumns[page.Sort]
+	if !ok {
+		orderBy = goalSortColumns["-start_date"]
+	}
+
+	sqlQuery := "SELECT id, metric, period, target, start_date, end_date, created_at, updated_at FROM goals" +
+		whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	goals := make([]domain.Goal, 0)
+
+	for rows.Next() {
+		goal := domain.Goal{
+			OwnerId: ownerId,
+		}
+
+		err := rows.Scan(
+			&goal.Id,
+			&goal.Metric,
+			&goal.Period,
+			&goal.Target,
+			&goal.StartDate,
+			&goal.EndDate,
+			&goal.CreatedAt,
+			&goal.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		goals = append(goals, goal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return goals, total, nil
+}
+
+func (repository *GoalRepositoryImpl) FindById(ctx context.Context, ownerId, goalId string) (domain.Goal, error) {
+	sqlQuery := `
+	SELECT metric, period, target, start_date, end_date, created_at, updated_at
+	FROM goals
+	WHERE id = $1 AND owner_id = $2
+	`
+
+	goal := domain.Goal{
+		Id:      goalId,
+		OwnerId: ownerId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, goalId, ownerId).Scan(
+		&goal.Metric,
+		&goal.Period,
+		&goal.Target,
+		&goal.StartDate,
+		&goal.EndDate,
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (repository *GoalRepositoryImpl) Update(ctx context.Context, goal domain.Goal) (domain.Goal, error) {
+	sqlQuery := `
+	UPDATE goals
+	SET metric = $1, period = $2, target = $3, start_date = $4, end_date = $5, updated_at = $6
+	WHERE id = $7 AND owner_id = $8
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		goal.Metric,
+		goal.Period,
+		goal.Target,
+		goal.StartDate,
+		goal.EndDate,
+		time.Now(),
+		goal.Id,
+		goal.OwnerId,
+	).Scan(
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Goal{}, err
+	}
+
+	return goal, nil
+}
+
+func (repository *GoalRepositoryImpl) Delete(ctx context.Context, ownerId, goalId string) error {
+	sqlQuery := `
+	DELETE FROM goals
+	WHERE id = $1 AND owner_id = $2
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, goalId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
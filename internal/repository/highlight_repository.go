@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type HighlightRepository interface {
+	SaveIfNotExists(ctx context.Context, highlight domain.Highlight) (domain.Highlight, bool, error)
+	FindAllByBookId(ctx context.Context, bookId, highlightType string, page domain.Page) ([]domain.Highlight, int, error)
+	DeleteAllByBookId(ctx context.Context, bookId string) error
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewHighlightRepository(db PgxDBTX) HighlightRepository {
+	return &HighlightRepositoryImpl{
+		DB: db,
+	}
+}
+
+type HighlightRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// SaveIfNotExists stores a highlight unless the book already has one with the same fingerprint,
+// the returned bool is false for such a duplicate. Location "" and Page 0 are stored as NULL
+func (repository *HighlightRepositoryImpl) SaveIfNotExists(ctx context.Context, highlight domain.Highlight) (domain.Highlight, bool, error) {
+	sqlQuery := `
+	INSERT INTO highlights (id, book_id, type, text, location, page, clipped_at, fingerprint)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, 0), $7, $8)
+	ON CONFLICT (book_id, fingerprint) DO NOTHING
+	RETURNING id, created_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		highlight.BookId,
+		highlight.Type,
+		highlight.Text,
+		highlight.Location,
+		highlight.Page,
+		highlight.ClippedAt,
+		highlight.Fingerprint,
+	).Scan(
+		&highlight.Id,
+		&highlight.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return highlight, false, nil
+		}
+		return domain.Highlight{}, false, err
+	}
+
+	return highlight, true, nil
+}
+
+// highlightSortColumns maps the sort query param to an ORDER BY clause, location sorts on the start of the range.
+// Highlights without a location or a clipping date come last and id is the tie breaker so pages are stable
+var highlightSortColumns = map[string]string{
+	"location":    "split_part(location, '-', 1)::INTEGER ASC NULLS LAST, clipped_at ASC NULLS LAST, id ASC",
+	"-location":   "split_part(location, '-', 1)::INTEGER DESC NULLS LAST, clipped_at DESC NULLS LAST, id DESC",
+	"clipped_at":  "clipped_at ASC NULLS LAST, id ASC",
+	"-clipped_at": "clipped_at DESC NULLS LAST, id DESC",
+}
+
+func (repository *HighlightRepositoryImpl) FindAllByBookId(ctx context.Context, bookId, highlightType string, page domain.Page) ([]domain.Highlight, int, error) {
+	whereClause := " WHERE book_id = $1"
+	args := []interface{}{bookId}
+	if highlightType != "" {
+		whereClause += " AND type = $2"
+		args = append(args, highlightType)
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM highlights"+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := highlightSortColumns[page.Sort]
+	if !ok {
+		orderBy = highlightSortColumns["location"]
+	}
+
+	sqlQuery := "SELECT id, type, text, COALESCE(location, ''), COALESCE(page, 0), clipped_at, fingerprint, created_at FROM highlights" +
+		whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	highlights := make([]domain.Highlight, 0)
+
+	for rows.Next() {
+		highlight := domain.Highlight{
+			BookId: bookId,
+		}
+
+		err := rows.Scan(
+			&highlight.Id,
+			&highlight.Type,
+			&highlight.Text,
+			&highlight.Location,
+			&highlight.Page,
+			&highlight.ClippedAt,
+			&highlight.Fingerprint,
+			&highlight.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		highlights = append(highlights, highlight)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return highlights, total, nil
+}
+
+// DeleteAllByBookId removes every highlight of a book, it runs in the transaction that deletes the book
+func (repository *HighlightRepositoryImpl) DeleteAllByBookId(ctx context.Context, bookId string) error {
+	sqlQuery := `
+	DELETE FROM highlights
+	WHERE book_id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, bookId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type NoteRepository interface {
+	Save(ctx context.Context, note domain.Note) (domain.Note, error)
+	FindAllByBookId(ctx context.Context, bookId, noteType string, page domain.Page) ([]domain.Note, int, error)
+	FindById(ctx context.Context, bookId, noteId string) (domain.Note, error)
+	Update(ctx context.Context, note domain.Note) (domain.Note, error)
+	Delete(ctx context.Context, noteId string) error
+	DeleteAllByBookId(ctx context.Context, bookId string) error
+	Search(ctx context.Context, ownerId, query, noteType string, page domain.Page) ([]domain.NoteSearchResult, int, error)
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewNoteRepository(db PgxDBTX) NoteRepository {
+	return &NoteRepositoryImpl{
+		DB: db,
+	}
+}
+
+type NoteRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// Save stores a Page of 0 as NULL, a note does not need a page reference
+func (repository *NoteRepositoryImpl) Save(ctx context.Context, note domain.Note) (domain.Note, error) {
+	sqlQuery := `
+	INSERT INTO notes (id, book_id, type, text, page)
+	VALUES ($1, $2, $3, $4, NULLIF($5, 0))
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		note.BookId,
+		note.Type,
+		note.Text,
+		note.Page,
+	).Scan(
+		&note.Id,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Note{}, err
+	}
+
+	return note, nil
+}
+
+// noteSortColumns maps the sort query param to an ORDER BY clause,
+// notes without a page come last and id is the tie breaker so pages are stable
+var noteSortColumns = map[string]string{
+	"page":        "page ASC NULLS LAST, created_at ASC, id ASC",
+	"-page":       "page DESC NULLS LAST, created_at DESC, id DESC",
+	"created_at":  "created_at ASC, id ASC",
+	"-created_at": "created_at DESC, id DESC",
+}
+
+func (repository *NoteRepositoryImpl) FindAllByBookId(ctx context.Context, bookId, noteType string, page domain.Page) ([]domain.Note, int, error) {
+	whereClause := " WHERE book_id = $1"
+	args := []interface{}{bookId}
+	if noteType != "" {
+		whereClause += " AND type = $2"
+		args = append(args, noteType)
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM notes"+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := noteSortColumns[page.Sort]
+	if !ok {
+		orderBy = noteSortColumns["page"]
+	}
+
+	sqlQuery := "SELECT id, type, text, COALESCE(page, 0), created_at, updated_at FROM notes" +
+		whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	notes := make([]domain.Note, 0)
+
+	for rows.Next() {
+		note := domain.Note{
+			BookId: bookId,
+		}
+
+		err := rows.Scan(
+			&note.Id,
+			&note.Type,
+			&note.Text,
+			&note.Page,
+			&note.CreatedAt,
+			&note.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		notes = append(notes, note)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return notes, total, nil
+}
+
+func (repository *NoteRepositoryImpl) FindById(ctx context.Context, bookId, noteId string) (domain.Note, error) {
+	sqlQuery := `
+	SELECT type, text, COALESCE(page, 0), created_at, updated_at
+	FROM notes
+	WHERE id = $1 AND book_id = $2
+	`
+
+	note := domain.Note{
+		Id:     noteId,
+		BookId: bookId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, noteId, bookId).Scan(
+		&note.Type,
+		&note.Text,
+		&note.Page,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Note{}, err
+	}
+
+	return note, nil
+}
+
+func (repository *NoteRepositoryImpl) Update(ctx context.Context, note domain.Note) (domain.Note, error) {
+	sqlQuery := `
+	UPDATE notes
+	SET type = $1, text = $2, page = NULLIF($3, 0), updated_at = $4
+	WHERE id = $5 AND book_id = $6
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		note.Type,
+		note.Text,
+		note.Page,
+		time.Now(),
+		note.Id,
+		note.BookId,
+	).Scan(
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Note{}, err
+	}
+
+	return note, nil
+}
+
+func (repository *NoteRepositoryImpl) Delete(ctx context.Context, noteId string) error {
+	sqlQuery := `
+	DELETE FROM notes
+	WHERE id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, noteId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllByBookId removes every note of a book, it runs in the transaction that deletes the book
+func (repository *NoteRepositoryImpl) DeleteAllByBookId(ctx context.Context, bookId string) error {
+	sqlQuery := `
+	DELETE FROM notes
+	WHERE book_id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, bookId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Search ranks the notes of every book of the owner like SearchRepository ranks books,
+// a note matches through its tsvector or through trigram word similarity.
+// The snippet marks matches like the book search does so the note text can be escaped by helper.HighlightSnippet
+func (repository *NoteRepositoryImpl) Search(ctx context.Context, ownerId, query, noteType string, page domain.Page) ([]domain.NoteSearchResult, int, error) {
+	baseQuery := `
+	SELECT n.id, n.book_id, b.name, n.type, COALESCE(n.page, 0),
+	       ts_headline('english', n.text, websearch_to_tsquery('english', $1::text), 'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) || ', MaxFragments=2') AS snippet,
+	       ts_rank(n.search_vector, websearch_to_tsquery('english', $1::text)) + word_similarity($1::text, n.text) AS rank
+	FROM notes n
+	JOIN books b ON n.book_id = b.id
+	WHERE b.owner_id = $2
+	  AND (n.search_vector @@ websearch_to_tsquery('english', $1::text) OR $1::text <% n.text)
+	`
+	args := []interface{}{query, ownerId}
+	if noteType != "" {
+		baseQuery += " AND n.type = $3"
+		args = append(args, noteType)
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM ("+baseQuery+") results", args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sqlQuery := baseQuery + " ORDER BY rank DESC, n.created_at DESC, n.id ASC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results := make([]domain.NoteSearchResult, 0)
+
+	for rows.Next() {
+		var result domain.NoteSearchResult
+
+		err := rows.Scan(
+			&result.Id,
+			&result.BookId,
+			&result.BookName,
+			&result.Type,
+			&result.Page,
+			&result.Snippet,
+			&result.Rank,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type ReadRepository interface {
+	Save(ctx context.Context, read domain.Read) (domain.Read, error)
+	FindAllByBookId(ctx context.Context, bookId string) ([]domain.Read, error)
+	FindById(ctx context.Context, bookId, readId string) (domain.Read, error)
+	FindInProgress(ctx context.Context, bookId string) (domain.Read, error)
+	Update(ctx context.Context, read domain.Read) (domain.Read, error)
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewReadRepository(db PgxDBTX) ReadRepository {
+	return &ReadRepositoryImpl{
+		DB: db,
+	}
+}
+
+type ReadRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// readColumns selects every column of reads, the nullable ones are read as empty strings and 0
+const readColumns = `
+	id, TO_CHAR(started_date, 'YYYY-MM-DD'), COALESCE(TO_CHAR(finished_date, 'YYYY-MM-DD'), ''),
+	COALESCE(outcome::text, ''), COALESCE(rating, 0)::float8, created_at, updated_at
+	`
+
+func (repository *ReadRepositoryImpl) Save(ctx context.Context, read domain.Read) (domain.Read, error) {
+	sqlQuery := `
+	INSERT INTO reads (id, book_id, started_date, finished_date, outcome, rating)
+	VALUES ($1, $2, $3::date, NULLIF($4, '')::date, NULLIF($5, '')::read_outcome, NULLIF($6::numeric, 0))
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		read.BookId,
+		read.StartedDate,
+		read.FinishedDate,
+		read.Outcome,
+		read.Rating,
+	).Scan(
+		&read.Id,
+		&read.CreatedAt,
+		&read.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Read{}, err
+	}
+
+	return read, nil
+}
+
+// FindAllByBookId returns the reads of a book, the read in progress first and then the latest to finish,
+// a read in progress may have been started before a read that is already finished
+func (repository *ReadRepositoryImpl) FindAllByBookId(ctx context.Context, bookId string) ([]domain.Read, error) {
+	sqlQuery := `
+	SELECT` + readColumns + `
+	FROM reads
+	WHERE book_id = $1
+	ORDER BY finished_date IS NULL DESC, finished_date DESC, started_date DESC, created_at DESC, id DESC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, bookId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reads := make([]domain.Read, 0)
+
+	for rows.Next() {
+		read := domain.Read{
+			BookId: bookId,
+		}
+
+		err := rows.Scan(
+			&read.Id,
+			&read.StartedDate,
+			&read.FinishedDate,
+			&read.Outcome,
+			&read.Rating,
+			&read.CreatedAt,
+			&read.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		reads = append(reads, read)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reads, nil
+}
+
+func (repository *ReadRepositoryImpl) FindById(ctx context.Context, bookId, readId string) (domain.Read, error) {
+	sqlQuery := `
+	SELECT` + readColumns + `
+	FROM reads
+	WHERE id = $1 AND book_id = $2
+	`
+
+	read := domain.Read{
+		BookId: bookId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, readId, bookId).Scan(
+		&read.Id,
+		&read.StartedDate,
+		&read.FinishedDate,
+		&read.Outcome,
+		&read.Rating,
+		&read.CreatedAt,
+		&read.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Read{}, err
+	}
+
+	return read, nil
+}
+
+// FindInProgress returns the read of a book that has no outcome yet, a book has at most one
+func (repository *ReadRepositoryImpl) FindInProgress(ctx context.Context, bookId string) (domain.Read, error) {
+	sqlQuery := `
+	SELECT` + readColumns + `
+	FROM reads
+	WHERE book_id = $1 AND outcome IS NULL
+	`
+
+	read := domain.Read{
+		BookId: bookId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, bookId).Scan(
+		&read.Id,
+		&read.StartedDate,
+		&read.FinishedDate,
+		&read.Outcome,
+		&read.Rating,
+		&read.CreatedAt,
+		&read.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Read{}, err
+	}
+
+	return read, nil
+}
+
+func (repository *ReadRepositoryImpl) Update(ctx context.Context, read domain.Read) (domain.Read, error) {
+	sqlQuery := `
+	UPDATE reads
+	SET started_date = $1::date, finished_date = NULLIF($2, '')::date, outcome = NULLIF($3, '')::read_outcome,
+	    rating = NULLIF($4::numeric, 0), updated_at = $5
+	WHERE id = $6 AND book_id = $7
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		read.StartedDate,
+		read.FinishedDate,
+		read.Outcome,
+		read.Rating,
+		time.Now(),
+		read.Id,
+		read.BookId,
+	).Scan(
+		&read.CreatedAt,
+		&read.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Read{}, err
+	}
+
+	return read, nil
+}
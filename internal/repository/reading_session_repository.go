@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type ReadingSessionRepository interface {
+	Save(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error)
+	FindAllByBookId(ctx context.Context, bookId string) ([]domain.ReadingSession, error)
+}
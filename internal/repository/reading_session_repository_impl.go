@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewReadingSessionRepository(db PgxDBTX) ReadingSessionRepository {
+	return &ReadingSessionRepositoryImpl{
+		DB: db,
+	}
+}
+
+type ReadingSessionRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *ReadingSessionRepositoryImpl) Save(ctx context.Context, session domain.ReadingSession) (domain.ReadingSession, error) {
+	sqlQuery := `
+	INSERT INTO reading_sessions (id, book_id, session_date, start_page, end_page, minutes_read)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		session.BookId,
+		session.SessionDate,
+		session.StartPage,
+		session.EndPage,
+		session.MinutesRead,
+	).Scan(
+		&session.Id,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		return domain.ReadingSession{}, err
+	}
+
+	return session, nil
+}
+
+func (repository *ReadingSessionRepositoryImpl) FindAllByBookId(ctx context.Context, bookId string) ([]domain.ReadingSession, error) {
+	sqlQuery := `
+	SELECT id, TO_CHAR(session_date, 'YYYY-MM-DD'), start_page, end_page, minutes_read, created_at, updated_at
+	FROM reading_sessions
+	WHERE book_id = $1
+	ORDER BY session_date DESC, created_at DESC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, bookId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]domain.ReadingSession, 0)
+
+	for rows.Next() {
+		session := domain.ReadingSession{
+			BookId: bookId,
+		}
+
+		err := rows.Scan(
+			&session.Id,
+			&session.SessionDate,
+			&session.StartPage,
+			&session.EndPage,
+			&session.MinutesRead,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
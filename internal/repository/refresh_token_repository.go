@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, refreshToken domain.RefreshToken) (domain.RefreshToken, error)
+	RevokeByTokenHash(ctx context.Context, tokenHash string, now time.Time) (domain.RefreshToken, error)
+}
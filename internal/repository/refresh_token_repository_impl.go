@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewRefreshTokenRepository(db PgxDBTX) RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{
+		DB: db,
+	}
+}
+
+type RefreshTokenRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *RefreshTokenRepositoryImpl) Save(ctx context.Context, refreshToken domain.RefreshToken) (domain.RefreshToken, error) {
+	sqlQuery := `
+	INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, created_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		refreshToken.UserId,
+		refreshToken.TokenHash,
+		refreshToken.ExpiresAt,
+	).Scan(
+		&refreshToken.Id,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+
+	return refreshToken, nil
+}
+
+// RevokeByTokenHash revokes the token if it is neither revoked nor expired and returns it. The check and the revoke
+// are one UPDATE, so of two requests with the same token only one gets a row, the other gets pgx.ErrNoRows
+func (repository *RefreshTokenRepositoryImpl) RevokeByTokenHash(ctx context.Context, tokenHash string, now time.Time) (domain.RefreshToken, error) {
+	sqlQuery := `
+	UPDATE refresh_tokens
+	SET revoked_at = $1
+	WHERE token_hash = $2 AND revoked_at IS NULL AND expires_at > $1
+	RETURNING id, user_id, expires_at, revoked_at, created_at
+	`
+
+	refreshToken := domain.RefreshToken{
+		TokenHash: tokenHash,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, now, tokenHash).Scan(
+		&refreshToken.Id,
+		&refreshToken.UserId,
+		&refreshToken.ExpiresAt,
+		&refreshToken.RevokedAt,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+
+	return refreshToken, nil
+}
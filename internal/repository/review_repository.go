@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type ReviewRepository interface {
+	Save(ctx context.Context, review domain.Review) (domain.Review, error)
+	FindByBookId(ctx context.Context, bookId string) (domain.Review, error)
+	Update(ctx context.Context, review domain.Review) (domain.Review, error)
+	FindRevisions(ctx context.Context, reviewId string) ([]domain.ReviewRevision, error)
+	Delete(ctx context.Context, reviewId string) error
+	FindAuthorRatings(ctx context.Context, ownerId string, page domain.Page) ([]domain.AuthorRating, int, error)
+}
@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewReviewRepository(db PgxDBTX) ReviewRepository {
+	return &ReviewRepositoryImpl{
+		DB: db,
+	}
+}
+
+type ReviewRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *ReviewRepositoryImpl) Save(ctx context.Context, review domain.Review) (domain.Review, error) {
+	sqlQuery := `
+	INSERT INTO reviews (id, book_id, rating, body)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		review.BookId,
+		review.Rating,
+		review.Body,
+	).Scan(
+		&review.Id,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	return review, nil
+}
+
+func (repository *ReviewRepositoryImpl) FindByBookId(ctx context.Context, bookId string) (domain.Review, error) {
+	sqlQuery := `
+	SELECT id, rating::float8, body, created_at, updated_at
+	FROM reviews
+	WHERE book_id = $1
+	`
+
+	review := domain.Review{
+		BookId: bookId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, bookId).Scan(
+		&review.Id,
+		&review.Rating,
+		&review.Body,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	return review, nil
+}
+
+// Update keeps the version being replaced as a revision before the review is overwritten
+func (repository *ReviewRepositoryImpl) Update(ctx context.Context, review domain.Review) (domain.Review, error) {
+	_, err := repository.DB.Exec(ctx, `
+	INSERT INTO review_revisions (id, review_id, rating, body, created_at)
+	SELECT $1, id, rating, body, updated_at
+	FROM reviews
+	WHERE id = $2
+	`, uuid.NewString(), review.Id)
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	sqlQuery := `
+	UPDATE reviews
+	SET rating = $1, body = $2, updated_at = $3
+	WHERE id = $4
+	RETURNING created_at, updated_at
+	`
+
+	err = repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		review.Rating,
+		review.Body,
+		time.Now(),
+		review.Id,
+	).Scan(
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	return review, nil
+}
+
+// FindRevisions returns the earlier versions of a review, the most recent first
+func (repository *ReviewRepositoryImpl) FindRevisions(ctx context.Context, reviewId string) ([]domain.ReviewRevision, error) {
+	sqlQuery := `
+	SELECT id, rating::float8, body, created_at
+	FROM review_revisions
+	WHERE review_id = $1
+	ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, reviewId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]domain.ReviewRevision, 0)
+
+	for rows.Next() {
+		revision := domain.ReviewRevision{
+			ReviewId: reviewId,
+		}
+
+		err := rows.Scan(
+			&revision.Id,
+			&revision.Rating,
+			&revision.Body,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// Delete removes a review together with its revisions
+func (repository *ReviewRepositoryImpl) Delete(ctx context.Context, reviewId string) error {
+	sqlQuery := `
+	DELETE FROM reviews
+	WHERE id = $1
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, reviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorRatingSortColumns maps the sort query param to an ORDER BY clause,
+// author_id is the tie breaker so pages are stable
+var authorRatingSortColumns = map[string]string{
+	"average":  "average ASC, count DESC, author_id ASC",
+	"-average": "average DESC, count DESC, author_id ASC",
+	"count":    "count ASC, average DESC, author_id ASC",
+	"-count":   "count DESC, average DESC, author_id ASC",
+	"name":     "full_name ASC, author_id ASC",
+	"-name":    "full_name DESC, author_id DESC",
+}
+
+// ratedBooksQuery lists the reviewed books of an owner once per author or co-author,
+// translators and illustrators are not rated for the book
+const ratedBooksQuery = `
+	SELECT DISTINCT bc.author_id, b.id AS book_id, r.rating
+	FROM reviews r
+	JOIN books b ON r.book_id = b.id
+	JOIN book_contributors bc ON bc.book_id = b.id AND bc.role IN ('author', 'co_author')
+	WHERE b.owner_id = $1
+`
+
+// FindAuthorRatings aggregates the reviews of the owner per author, authors without a rated book are left out
+func (repository *ReviewRepositoryImpl) FindAuthorRatings(ctx context.Context, ownerId string, page domain.Page) ([]domain.AuthorRating, int, error) {
+	// Count every rated author before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, `
+	SELECT COUNT(DISTINCT author_id)
+	FROM (`+ratedBooksQuery+`) rated
+	`, ownerId).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := authorRatingSortColumns[page.Sort]
+	if !ok {
+		orderBy = authorRatingSortColumns["-average"]
+	}
+
+	sqlQuery := `
+	SELECT rated.author_id, a.full_name, ROUND(AVG(rated.rating), 2)::float8 AS average, COUNT(*) AS count
+	FROM (` + ratedBooksQuery + `) rated
+	JOIN authors a ON rated.author_id = a.id
+	GROUP BY rated.author_id, a.full_name
+	ORDER BY ` + orderBy + `
+	LIMIT $2 OFFSET $3
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	ratings := make([]domain.AuthorRating, 0)
+	authorIds := make([]string, 0)
+
+	for rows.Next() {
+		var rating domain.AuthorRating
+
+		err := rows.Scan(
+			&rating.AuthorId,
+			&rating.FullName,
+			&rating.Average,
+			&rating.Count,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ratings = append(ratings, rating)
+		authorIds = append(authorIds, rating.AuthorId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	distributions, err := repository.findDistributions(ctx, ownerId, authorIds)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i := range ratings {
+		ratings[i].Distribution = distributions[ratings[i].AuthorId]
+	}
+
+	return ratings, total, nil
+}
+
+// findDistributions counts the reviews of every rating for the given authors,
+// every author gets all ten buckets so clients can draw a histogram without filling gaps
+func (repository *ReviewRepositoryImpl) findDistributions(ctx context.Context, ownerId string, authorIds []string) (map[string][]domain.RatingBucket, error) {
+	distributions := map[string][]domain.RatingBucket{}
+	if len(authorIds) == 0 {
+		return distributions, nil
+	}
+
+	sqlQuery := `
+	SELECT a.author_id, buckets.rating::float8, COUNT(rated.book_id)
+	FROM UNNEST($2::text[]::uuid[]) AS a(author_id)
+	CROSS JOIN (SELECT step / 2.0 AS rating FROM generate_series(1, 10) AS step) buckets
+	LEFT JOIN (` + ratedBooksQuery + `) rated ON rated.author_id = a.author_id AND rated.rating = buckets.rating
+	GROUP BY a.author_id, buckets.rating
+	ORDER BY a.author_id, buckets.rating
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var authorId string
+		var bucket domain.RatingBucket
+
+		err := rows.Scan(&authorId, &bucket.Rating, &bucket.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		distributions[authorId] = append(distributions[authorId], bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return distributions, nil
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type SearchRepository interface {
+	Search(ctx context.Context, ownerId, query, scope string, page domain.Page) ([]domain.SearchResult, int, error)
+}
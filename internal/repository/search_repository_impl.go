@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewSearchRepository(db PgxDBTX) SearchRepository {
+	return &SearchRepositoryImpl{
+		DB: db,
+	}
+}
+
+type SearchRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// Book titles are stemmed as English, author names and aliases are matched as they are written.
+// A row matches either through the tsvector or through trigram word similarity so typos still match,
+// both are served by the GIN indexes of migration 000008.
+// Matches are marked with helper.SnippetStartSel and helper.SnippetStopSel, not with HTML, since the names around
+// them are not escaped by ts_headline
+const (
+	searchHeadlineOptions = `'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) || ', HighlightAll=true'`
+
+	searchBooksQuery = `
+	SELECT 'book' AS type, b.id, b.name AS title,
+	       ts_headline('english', b.name, websearch_to_tsquery('english', $1::text), ` + searchHeadlineOptions + `) AS snippet,
+	       ts_rank(b.search_vector, websearch_to_tsquery('english', $1::text)) + word_similarity($1::text, b.name) AS rank
+	FROM books b
+	WHERE b.owner_id = $2
+	  AND (b.search_vector @@ websearch_to_tsquery('english', $1::text) OR $1::text <% b.name)
+	`
+	searchAuthorsQuery = `
+	SELECT 'author' AS type, a.id, a.full_name AS title,
+	       ts_headline('simple', a.full_name, websearch_to_tsquery('simple', $1::text), ` + searchHeadlineOptions + `) AS snippet,
+	       ts_rank(a.search_vector, websearch_to_tsquery('simple', $1::text)) + GREATEST(word_similarity($1::text, a.full_name), COALESCE((
+	           SELECT MAX(word_similarity($1::text, al.name)) FROM author_aliases al WHERE al.author_id = a.id
+	       ), 0)) AS rank
+	FROM authors a
+	WHERE (a.search_vector @@ websearch_to_tsquery('simple', $1::text) OR $1::text <% a.full_name OR EXISTS (
+		SELECT 1 FROM author_aliases al
+		WHERE al.author_id = a.id
+		  AND (to_tsvector('simple', al.name) @@ websearch_to_tsquery('simple', $1::text) OR $1::text <% al.name)
+	))
+	`
+)
+
+func (repository *SearchRepositoryImpl) Search(ctx context.Context, ownerId, query, scope string, page domain.Page) ([]domain.SearchResult, int, error) {
+	// Books are scoped to their owner, authors are a shared catalog
+	args := []interface{}{query}
+	parts := []string{}
+	if scope != domain.SearchScopeAuthors {
+		parts = append(parts, searchBooksQuery)
+		args = append(args, ownerId)
+	}
+	if scope != domain.SearchScopeBooks {
+		parts = append(parts, searchAuthorsQuery)
+	}
+
+	unionQuery := strings.Join(parts, " UNION ALL ")
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM ("+unionQuery+") results", args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sqlQuery := "SELECT type, id, title, snippet, rank FROM (" + unionQuery + ") results" +
+		" ORDER BY rank DESC, title ASC, id ASC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results := make([]domain.SearchResult, 0)
+
+	for rows.Next() {
+		var result domain.SearchResult
+
+		err := rows.Scan(
+			&result.Type,
+			&result.Id,
+			&result.Title,
+			&result.Snippet,
+			&result.Rank,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
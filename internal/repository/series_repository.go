@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type SeriesRepository interface {
+	Save(ctx context.Context, series domain.Series) (domain.Series, error)
+	CheckByNameAndAuthorId(ctx context.Context, ownerId, name, authorId string) error
+	FindAll(ctx context.Context, ownerId, name, authorId string, page domain.Page) ([]domain.Series, int, error)
+	FindById(ctx context.Context, ownerId, seriesId string) (domain.Series, error)
+	Update(ctx context.Context, ownerId, seriesId string, series domain.Series) (domain.Series, error)
+	Delete(ctx context.Context, ownerId, seriesId string) error
+	ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error
+	MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewSeriesRepository(db PgxDBTX) SeriesRepository {
+	return &SeriesRepositoryImpl{
+		DB: db,
+	}
+}
+
+type SeriesRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// Save stores an empty AuthorId as NULL, a series does not need a single author
+func (repository *SeriesRepositoryImpl) Save(ctx context.Context, series domain.Series) (domain.Series, error) {
+	sqlQuery := `
+	INSERT INTO series (id, owner_id, name, author_id)
+	VALUES ($1, $2, $3, NULLIF($4, '')::uuid)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		series.OwnerId,
+		series.Name,
+		series.AuthorId,
+	).Scan(
+		&series.Id,
+		&series.CreatedAt,
+		&series.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Series{}, err
+	}
+
+	return series, nil
+}
+
+func (repository *SeriesRepositoryImpl) CheckByNameAndAuthorId(ctx context.Context, ownerId, name, authorId string) error {
+	sqlQuery := `
+	SELECT 1 FROM series
+	WHERE owner_id = $1 AND name = $2 AND author_id IS NOT DISTINCT FROM NULLIF($3, '')::uuid
+	`
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, name, authorId).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("series %v is already exists", name)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// seriesSortColumns maps the sort query param to an ORDER BY clause,
+// id is the tie breaker so pages are stable
+var seriesSortColumns = map[string]string{
+	"name":        "name ASC, id ASC",
+	"-name":       "name DESC, id DESC",
+	"created_at":  "created_at ASC, id ASC",
+	"-created_at": "created_at DESC, id DESC",
+}
+
+func (repository *SeriesRepositoryImpl) FindAll(ctx context.Context, ownerId, name, authorId string, page domain.Page) ([]domain.Series, int, error) {
+	baseQuery := `
+	SELECT id, owner_id, name, COALESCE(author_id::text, ''), created_at, updated_at
+	FROM series
+	`
+	countQuery := `
+	SELECT COUNT(*)
+	FROM series
+	`
+
+	// Slice to aggregate arguments and WHERE condition dynamically
+	args := []interface{}{ownerId}
+	conditions := []string{"owner_id = $1"}
+	argCount := 2
+
+	if name != "" {
+		conditions = append(conditions, fmt.Sprintf("name ILIKE $%d", argCount))
+		args = append(args, "%"+name+"%")
+		argCount++
+	}
+	if authorId != "" {
+		conditions = append(conditions, fmt.Sprintf("author_id = $%d", argCount))
+		args = append(args, authorId)
+		argCount++
+	}
+
+	whereClause := " WHERE " + strings.Join(conditions, " AND ")
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, countQuery+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := seriesSortColumns[page.Sort]
+	if !ok {
+		orderBy = seriesSortColumns["name"]
+	}
+
+	sqlQuery := baseQuery + whereClause + " ORDER BY " + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	seriesList := make([]domain.Series, 0)
+
+	for rows.Next() {
+		var series domain.Series
+
+		err := rows.Scan(
+			&series.Id,
+			&series.OwnerId,
+			&series.Name,
+			&series.AuthorId,
+			&series.CreatedAt,
+			&series.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		seriesList = append(seriesList, series)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return seriesList, total, nil
+}
+
+func (repository *SeriesRepositoryImpl) FindById(ctx context.Context, ownerId, seriesId string) (domain.Series, error) {
+	sqlQuery := `
+	SELECT name, COALESCE(author_id::text, ''), created_at, updated_at
+	FROM series
+	WHERE id = $1 AND owner_id = $2
+	`
+
+	series := domain.Series{
+		Id:      seriesId,
+		OwnerId: ownerId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, seriesId, ownerId).Scan(
+		&series.Name,
+		&series.AuthorId,
+		&series.CreatedAt,
+		&series.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Series{}, err
+	}
+
+	return series, nil
+}
+
+func (repository *SeriesRepositoryImpl) Update(ctx context.Context, ownerId, seriesId string, series domain.Series) (domain.Series, error) {
+	sqlQuery := `
+	UPDATE series
+	SET name = $1, author_id = NULLIF($2, '')::uuid, updated_at = $3
+	WHERE id = $4 AND owner_id = $5
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		series.Name,
+		series.AuthorId,
+		time.Now(),
+		seriesId,
+		ownerId,
+	).Scan(
+		&series.CreatedAt,
+		&series.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Series{}, err
+	}
+
+	return series, nil
+}
+
+// Delete takes the owner's books out of the series before the series itself is deleted,
+// the books are kept
+func (repository *SeriesRepositoryImpl) Delete(ctx context.Context, ownerId, seriesId string) error {
+	_, err := repository.DB.Exec(ctx, `
+	UPDATE books
+	SET series_id = NULL, series_position = NULL
+	WHERE series_id = $1 AND owner_id = $2
+	`, seriesId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	_, err = repository.DB.Exec(ctx, `
+	DELETE FROM series
+	WHERE id = $1 AND owner_id = $2
+	`, seriesId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReassignAuthor moves the series of the owner from an author to another author
+func (repository *SeriesRepositoryImpl) ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error {
+	_, err := repository.DB.Exec(ctx, `
+	UPDATE series
+	SET author_id = $2, updated_at = CURRENT_TIMESTAMP
+	WHERE author_id = $1 AND owner_id = $3
+	`, fromAuthorId, toAuthorId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MergeAuthor moves the series of every owner from an author to the author it duplicates
+func (repository *SeriesRepositoryImpl) MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	_, err := repository.DB.Exec(ctx, `
+	UPDATE series
+	SET author_id = $2, updated_at = CURRENT_TIMESTAMP
+	WHERE author_id = $1
+	`, fromAuthorId, toAuthorId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
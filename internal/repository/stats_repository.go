@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type StatsRepository interface {
+	FindTotals(ctx context.Context, ownerId string, from, to time.Time) (domain.ReadingTotals, error)
+	FindTimeline(ctx context.Context, ownerId string, from, to time.Time, groupBy string) ([]domain.StatsBucket, error)
+	FindStatusCounts(ctx context.Context, ownerId string) ([]domain.StatusCount, error)
+	FindTopAuthors(ctx context.Context, ownerId string, from, to time.Time, limit int) ([]domain.AuthorStats, error)
+	FindNationalities(ctx context.Context, ownerId string, from, to time.Time) ([]domain.NationalityStats, error)
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewStatsRepository(db PgxDBTX) StatsRepository {
+	return &StatsRepositoryImpl{
+		DB: db,
+	}
+}
+
+type StatsRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+// statsReadingCTE selects the reads of the owner ($1) finished between $2 and $3 and the pages read in that range.
+// A book read twice in the range is completed twice, reread tells whether the book had been finished before.
+// Logged sessions are used where they exist, a finished read of a book without any session counts its total_page
+// on its finished_date
+const statsReadingCTE = `
+	WITH completed AS (
+		SELECT b.id, b.total_page, r.finished_date AS day,
+		       (SELECT bc.author_id FROM book_contributors bc WHERE bc.book_id = b.id ORDER BY bc.position ASC LIMIT 1) AS author_id,
+		       EXISTS (
+		           SELECT 1 FROM reads p
+		           WHERE p.book_id = r.book_id AND p.outcome = 'finished'
+		             AND (p.finished_date, p.created_at) < (r.finished_date, r.created_at)
+		       ) AS reread
+		FROM reads r
+		JOIN books b ON r.book_id = b.id
+		WHERE b.owner_id = $1 AND r.outcome = 'finished'
+		  AND r.finished_date BETWEEN $2::date AND $3::date
+	),
+	pages_read AS (
+		SELECT rs.session_date AS day, rs.end_page - rs.start_page AS pages
+		FROM reading_sessions rs
+		JOIN books b ON rs.book_id = b.id
+		WHERE b.owner_id = $1 AND rs.session_date BETWEEN $2::date AND $3::date
+		UNION ALL
+		SELECT c.day, c.total_page
+		FROM completed c
+		WHERE NOT EXISTS (SELECT 1 FROM reading_sessions rs WHERE rs.book_id = c.id)
+	)
+	`
+
+func (repository *StatsRepositoryImpl) FindTotals(ctx context.Context, ownerId string, from, to time.Time) (domain.ReadingTotals, error) {
+	sqlQuery := statsReadingCTE + `
+	SELECT
+		(SELECT COUNT(*) FROM completed),
+		(SELECT COUNT(*) FROM completed WHERE reread),
+		(SELECT COALESCE(SUM(pages), 0) FROM pages_read),
+		(SELECT COALESCE(ROUND(AVG(total_page), 1), 0)::float8 FROM (SELECT DISTINCT id, total_page FROM completed) books)
+	`
+
+	var totals domain.ReadingTotals
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, from, to).Scan(
+		&totals.Books,
+		&totals.Rereads,
+		&totals.Pages,
+		&totals.AverageBookLength,
+	)
+	if err != nil {
+		return domain.ReadingTotals{}, err
+	}
+
+	return totals, nil
+}
+
+// FindTimeline returns a bucket for every day, week, month or year between from and to, including empty ones.
+// Weeks start on Monday
+func (repository *StatsRepositoryImpl) FindTimeline(ctx context.Context, ownerId string, from, to time.Time, groupBy string) ([]domain.StatsBucket, error) {
+	sqlQuery := statsReadingCTE + `
+	SELECT buckets.period::date, COALESCE(books.count, 0), COALESCE(pages.sum, 0)
+	FROM generate_series(date_trunc($4::text, $2::timestamp), $3::timestamp, ('1 ' || $4::text)::interval) AS buckets(period)
+	LEFT JOIN (
+		SELECT date_trunc($4::text, day::timestamp) AS period, COUNT(*) AS count
+		FROM completed
+		GROUP BY 1
+	) books ON books.period = buckets.period
+	LEFT JOIN (
+		SELECT date_trunc($4::text, day::timestamp) AS period, SUM(pages) AS sum
+		FROM pages_read
+		GROUP BY 1
+	) pages ON pages.period = buckets.period
+	ORDER BY buckets.period ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, from, to, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]domain.StatsBucket, 0)
+
+	for rows.Next() {
+		var bucket domain.StatsBucket
+
+		err := rows.Scan(
+			&bucket.PeriodStart,
+			&bucket.BooksCompleted,
+			&bucket.PagesRead,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// FindStatusCounts splits every book on the shelf by its current status, statuses without books are included
+func (repository *StatsRepositoryImpl) FindStatusCounts(ctx context.Context, ownerId string) ([]domain.StatusCount, error) {
+	sqlQuery := `
+	SELECT s.status::text, COUNT(b.id)
+	FROM unnest(enum_range(NULL::status)) AS s(status)
+	LEFT JOIN books b ON b.status = s.status AND b.owner_id = $1
+	GROUP BY s.status
+	ORDER BY s.status ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make([]domain.StatusCount, 0)
+
+	for rows.Next() {
+		var count domain.StatusCount
+
+		err := rows.Scan(
+			&count.Status,
+			&count.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// FindTopAuthors ranks the main authors of the books completed between from and to, re-reads count again
+func (repository *StatsRepositoryImpl) FindTopAuthors(ctx context.Context, ownerId string, from, to time.Time, limit int) ([]domain.AuthorStats, error) {
+	sqlQuery := statsReadingCTE + `
+	SELECT a.id, a.full_name, COUNT(*), SUM(c.total_page)
+	FROM completed c
+	JOIN authors a ON c.author_id = a.id
+	GROUP BY a.id, a.full_name
+	ORDER BY COUNT(*) DESC, SUM(c.total_page) DESC, a.full_name ASC, a.id ASC
+	LIMIT $4
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	authors := make([]domain.AuthorStats, 0)
+
+	for rows.Next() {
+		var author domain.AuthorStats
+
+		err := rows.Scan(
+			&author.AuthorId,
+			&author.FullName,
+			&author.BooksCompleted,
+			&author.Pages,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		authors = append(authors, author)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return authors, nil
+}
+
+// FindNationalities groups the books completed between from and to by the nationality of their main author
+func (repository *StatsRepositoryImpl) FindNationalities(ctx context.Context, ownerId string, from, to time.Time) ([]domain.NationalityStats, error) {
+	sqlQuery := statsReadingCTE + `
+	SELECT a.nationality, COUNT(*), COUNT(DISTINCT a.id)
+	FROM completed c
+	JOIN authors a ON c.author_id = a.id
+	GROUP BY a.nationality
+	ORDER BY COUNT(*) DESC, a.nationality ASC
+	`
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, ownerId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nationalities := make([]domain.NationalityStats, 0)
+
+	for rows.Next() {
+		var nationality domain.NationalityStats
+
+		err := rows.Scan(
+			&nationality.Nationality,
+			&nationality.BooksCompleted,
+			&nationality.Authors,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nationalities = append(nationalities, nationality)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return nationalities, nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type TagRepository interface {
+	Save(ctx context.Context, tag domain.Tag) (domain.Tag, error)
+	CheckByName(ctx context.Context, ownerId, name string) error
+	FindAll(ctx context.Context, ownerId, name string, page domain.Page) ([]domain.Tag, int, error)
+	FindById(ctx context.Context, ownerId, tagId string) (domain.Tag, error)
+	Update(ctx context.Context, ownerId, tagId string, tag domain.Tag) (domain.Tag, error)
+	Delete(ctx context.Context, ownerId, tagId string) error
+	AttachToBook(ctx context.Context, bookId, tagId string) error
+	DetachFromBook(ctx context.Context, bookId, tagId string) (bool, error)
+}
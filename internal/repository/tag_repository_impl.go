@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewTagRepository(db PgxDBTX) TagRepository {
+	return &TagRepositoryImpl{
+		DB: db,
+	}
+}
+
+type TagRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *TagRepositoryImpl) Save(ctx context.Context, tag domain.Tag) (domain.Tag, error) {
+	sqlQuery := `
+	INSERT INTO tags (id, owner_id, name)
+	VALUES ($1, $2, $3)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		tag.OwnerId,
+		tag.Name,
+	).Scan(
+		&tag.Id,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+// CheckByName matches tag names regardless of case, like the unique index on tags
+func (repository *TagRepositoryImpl) CheckByName(ctx context.Context, ownerId, name string) error {
+	sqlQuery := `
+	SELECT 1 FROM tags
+	WHERE owner_id = $1 AND LOWER(name) = LOWER($2)
+	`
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, ownerId, name).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("tag %v is already exists", name)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// tagSortColumns maps the sort query param to an ORDER BY clause,
+// id is the tie breaker so pages are stable
+var tagSortColumns = map[string]string{
+	"name":        "LOWER(t.name) ASC, t.id ASC",
+	"-name":       "LOWER(t.name) DESC, t.id DESC",
+	"book_count":  "book_count ASC, LOWER(t.name) ASC, t.id ASC",
+	"-book_count": "book_count DESC, LOWER(t.name) ASC, t.id ASC",
+}
+
+func (repository *TagRepositoryImpl) FindAll(ctx context.Context, ownerId, name string, page domain.Page) ([]domain.Tag, int, error) {
+	// Slice to aggregate arguments and WHERE condition dynamically,
+	// tags are always scoped to their owner
+	args := []interface{}{ownerId}
+	whereClause := " WHERE t.owner_id = $1"
+	argCount := 2
+
+	if name != "" {
+		whereClause += fmt.Sprintf(" AND t.name ILIKE $%d", argCount)
+		args = append(args, "%"+name+"%")
+		argCount++
+	}
+
+	// Count every matching row before LIMIT is applied
+	var total int
+	err := repository.DB.QueryRow(ctx, "SELECT COUNT(*) FROM tags t"+whereClause, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := tagSortColumns[page.Sort]
+	if !ok {
+		orderBy = tagSortColumns["name"]
+	}
+
+	// Usage counts come from the join so unused tags are listed with 0
+	sqlQuery := `
+	SELECT t.id, t.name, COUNT(bt.book_id) AS book_count, t.created_at, t.updated_at
+	FROM tags t
+	LEFT JOIN book_tags bt ON bt.tag_id = t.id
+	` + whereClause + `
+	GROUP BY t.id
+	ORDER BY ` + orderBy +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := repository.DB.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	tags := make([]domain.Tag, 0)
+
+	for rows.Next() {
+		tag := domain.Tag{
+			OwnerId: ownerId,
+		}
+
+		err := rows.Scan(
+			&tag.Id,
+			&tag.Name,
+			&tag.BookCount,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return tags, total, nil
+}
+
+func (repository *TagRepositoryImpl) FindById(ctx context.Context, ownerId, tagId string) (domain.Tag, error) {
+	sqlQuery := `
+	SELECT t.name, (SELECT COUNT(*) FROM book_tags bt WHERE bt.tag_id = t.id), t.created_at, t.updated_at
+	FROM tags t
+	WHERE t.id = $1 AND t.owner_id = $2
+	`
+
+	tag := domain.Tag{
+		Id:      tagId,
+		OwnerId: ownerId,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, tagId, ownerId).Scan(
+		&tag.Name,
+		&tag.BookCount,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (repository *TagRepositoryImpl) Update(ctx context.Context, ownerId, tagId string, tag domain.Tag) (domain.Tag, error) {
+	sqlQuery := `
+	UPDATE tags
+	SET name = $1, updated_at = $2
+	WHERE id = $3 AND owner_id = $4
+	RETURNING created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		tag.Name,
+		time.Now(),
+		tagId,
+		ownerId,
+	).Scan(
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+// Delete also detaches the tag from every book through ON DELETE CASCADE
+func (repository *TagRepositoryImpl) Delete(ctx context.Context, ownerId, tagId string) error {
+	sqlQuery := `
+	DELETE FROM tags
+	WHERE id = $1 AND owner_id = $2
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, tagId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttachToBook is idempotent, attaching a tag twice keeps a single link
+func (repository *TagRepositoryImpl) AttachToBook(ctx context.Context, bookId, tagId string) error {
+	sqlQuery := `
+	INSERT INTO book_tags (book_id, tag_id)
+	VALUES ($1, $2)
+	ON CONFLICT (book_id, tag_id) DO NOTHING
+	`
+
+	_, err := repository.DB.Exec(ctx, sqlQuery, bookId, tagId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DetachFromBook reports whether the tag was attached to the book
+func (repository *TagRepositoryImpl) DetachFromBook(ctx context.Context, bookId, tagId string) (bool, error) {
+	sqlQuery := `
+	DELETE FROM book_tags
+	WHERE book_id = $1 AND tag_id = $2
+	`
+
+	commandTag, err := repository.DB.Exec(ctx, sqlQuery, bookId, tagId)
+	if err != nil {
+		return false, err
+	}
+
+	return commandTag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+type UserRepository interface {
+	Save(ctx context.Context, user domain.User) (domain.User, error)
+	CheckByEmail(ctx context.Context, email string) error
+	FindByEmail(ctx context.Context, email string) (domain.User, error)
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+func NewUserRepository(db PgxDBTX) UserRepository {
+	return &UserRepositoryImpl{
+		DB: db,
+	}
+}
+
+type UserRepositoryImpl struct {
+	DB PgxDBTX
+}
+
+func (repository *UserRepositoryImpl) Save(ctx context.Context, user domain.User) (domain.User, error) {
+	sqlQuery := `
+	INSERT INTO users (id, full_name, email, password)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, created_at, updated_at
+	`
+
+	err := repository.DB.QueryRow(
+		ctx,
+		sqlQuery,
+		uuid.NewString(),
+		user.FullName,
+		user.Email,
+		user.Password,
+	).Scan(
+		&user.Id,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
+
+func (repository *UserRepositoryImpl) CheckByEmail(ctx context.Context, email string) error {
+	sqlQuery := `
+	SELECT 1 FROM users
+	WHERE email = $1
+	`
+
+	var exists int
+	err := repository.DB.QueryRow(ctx, sqlQuery, email).Scan(&exists)
+	if exists == 1 {
+		return fmt.Errorf("user with email %v is already exists", email)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (repository *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (domain.User, error) {
+	sqlQuery := `
+	SELECT id, full_name, password, created_at, updated_at
+	FROM users
+	WHERE email = $1
+	`
+
+	user := domain.User{
+		Email: email,
+	}
+
+	err := repository.DB.QueryRow(ctx, sqlQuery, email).Scan(
+		&user.Id,
+		&user.FullName,
+		&user.Password,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return user, nil
+}
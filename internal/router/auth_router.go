@@ -9,4 +9,6 @@ import (
 func AuthRouter(handler handler.AuthHandler, mux *http.ServeMux) {
 	mux.HandleFunc("POST /api/v1/auth/register", handler.Register)
 	mux.HandleFunc("POST /api/v1/auth/login", handler.Login)
+	mux.HandleFunc("POST /api/v1/auth/refresh", handler.Refresh)
+	mux.HandleFunc("POST /api/v1/auth/logout", handler.Logout)
 }
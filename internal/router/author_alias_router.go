@@ -0,0 +1,15 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func AuthorAliasRouter(handler handler.AuthorAliasHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/authors/{id}/aliases", handler.Create)
+	mux.HandleFunc("GET /api/v1/authors/{id}/aliases", handler.GetAll)
+	mux.HandleFunc("GET /api/v1/authors/{id}/aliases/{alias_id}", handler.GetById)
+	mux.HandleFunc("PUT /api/v1/authors/{id}/aliases/{alias_id}", handler.UpdateById)
+	mux.HandleFunc("DELETE /api/v1/authors/{id}/aliases/{alias_id}", handler.DeleteById)
+}
@@ -12,4 +12,5 @@ func AuthorRouter(handler handler.AuthorHandler, mux *http.ServeMux) {
 	mux.HandleFunc("GET /api/v1/authors/{id}", handler.GetById)
 	mux.HandleFunc("PUT /api/v1/authors/{id}", handler.UpdateById)
 	mux.HandleFunc("DELETE /api/v1/authors/{id}", handler.DeleteById)
+	mux.HandleFunc("POST /api/v1/authors/{id}/merge", handler.Merge)
 }
@@ -9,6 +9,7 @@ import (
 func BookRouter(handler handler.BookHandler, mux *http.ServeMux) {
 	mux.HandleFunc("POST /api/v1/books", handler.Create)
 	mux.HandleFunc("GET /api/v1/books", handler.GetAll)
+	mux.HandleFunc("POST /api/v1/books/lookup", handler.Lookup)
 	mux.HandleFunc("GET /api/v1/books/{id}", handler.GetById)
 	mux.HandleFunc("PUT /api/v1/books/{id}", handler.UpdateById)
 	mux.HandleFunc("DELETE /api/v1/books/{id}", handler.DeleteById)
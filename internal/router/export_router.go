@@ -0,0 +1,11 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func ExportRouter(handler handler.ExportHandler, mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/export", handler.ExportBooks)
+}
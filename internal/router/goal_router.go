@@ -0,0 +1,16 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func GoalRouter(handler handler.GoalHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/goals", handler.Create)
+	mux.HandleFunc("GET /api/v1/goals", handler.GetAll)
+	mux.HandleFunc("GET /api/v1/goals/{id}", handler.GetById)
+	mux.HandleFunc("PUT /api/v1/goals/{id}", handler.UpdateById)
+	mux.HandleFunc("DELETE /api/v1/goals/{id}", handler.DeleteById)
+	mux.HandleFunc("GET /api/v1/goals/{id}/progress", handler.GetProgress)
+}
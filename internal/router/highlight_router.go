@@ -0,0 +1,11 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func HighlightRouter(handler handler.HighlightHandler, mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/books/{id}/highlights", handler.GetAll)
+}
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func ImportRouter(handler handler.ImportHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/books/import", handler.ImportBooks)
+	mux.HandleFunc("POST /api/v1/highlights/import/kindle", handler.ImportKindleClippings)
+	mux.HandleFunc("POST /api/v1/highlights/import/kobo", handler.ImportKoboAnnotations)
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func NoteRouter(handler handler.NoteHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/books/{id}/notes", handler.Create)
+	mux.HandleFunc("GET /api/v1/books/{id}/notes", handler.GetAll)
+	mux.HandleFunc("GET /api/v1/books/{id}/notes/{note_id}", handler.GetById)
+	mux.HandleFunc("PUT /api/v1/books/{id}/notes/{note_id}", handler.UpdateById)
+	mux.HandleFunc("DELETE /api/v1/books/{id}/notes/{note_id}", handler.DeleteById)
+	mux.HandleFunc("GET /api/v1/notes/search", handler.Search)
+}
@@ -0,0 +1,14 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func ReadRouter(handler handler.ReadHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/books/{id}/reads", handler.Start)
+	mux.HandleFunc("GET /api/v1/books/{id}/reads", handler.GetAll)
+	mux.HandleFunc("POST /api/v1/books/{id}/reads/{read_id}/finish", handler.Finish)
+	mux.HandleFunc("POST /api/v1/books/{id}/reads/{read_id}/abandon", handler.Abandon)
+}
@@ -0,0 +1,12 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func ReadingSessionRouter(handler handler.ReadingSessionHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/books/{id}/sessions", handler.Create)
+	mux.HandleFunc("GET /api/v1/books/{id}/sessions", handler.GetAll)
+}
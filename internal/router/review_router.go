@@ -0,0 +1,15 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func ReviewRouter(handler handler.ReviewHandler, mux *http.ServeMux) {
+	mux.HandleFunc("PUT /api/v1/books/{id}/review", handler.PutBookReview)
+	mux.HandleFunc("GET /api/v1/books/{id}/review", handler.GetBookReview)
+	mux.HandleFunc("DELETE /api/v1/books/{id}/review", handler.DeleteBookReview)
+	mux.HandleFunc("GET /api/v1/books/{id}/review/history", handler.GetBookReviewHistory)
+	mux.HandleFunc("GET /api/v1/authors/ratings", handler.GetAuthorRatings)
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func SeriesRouter(handler handler.SeriesHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/series", handler.Create)
+	mux.HandleFunc("GET /api/v1/series", handler.GetAll)
+	mux.HandleFunc("GET /api/v1/series/{id}", handler.GetById)
+	mux.HandleFunc("PUT /api/v1/series/{id}", handler.UpdateById)
+	mux.HandleFunc("DELETE /api/v1/series/{id}", handler.DeleteById)
+	mux.HandleFunc("GET /api/v1/series/{id}/books", handler.GetBooks)
+	mux.HandleFunc("GET /api/v1/series/{id}/next", handler.GetNextUnread)
+}
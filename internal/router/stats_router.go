@@ -0,0 +1,11 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func StatsRouter(handler handler.StatsHandler, mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/stats", handler.GetStats)
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/mhaatha/go-bookshelf/internal/handler"
+)
+
+func TagRouter(handler handler.TagHandler, mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/tags", handler.Create)
+	mux.HandleFunc("GET /api/v1/tags", handler.GetAll)
+	mux.HandleFunc("GET /api/v1/tags/{id}", handler.GetById)
+	mux.HandleFunc("PUT /api/v1/tags/{id}", handler.UpdateById)
+	mux.HandleFunc("DELETE /api/v1/tags/{id}", handler.DeleteById)
+	mux.HandleFunc("PUT /api/v1/books/{id}/tags/{tag_id}", handler.AttachToBook)
+	mux.HandleFunc("DELETE /api/v1/books/{id}/tags/{tag_id}", handler.DetachFromBook)
+}
@@ -8,4 +8,5 @@ import (
 
 func UploadRouter(handler handler.UploadHandler, mux *http.ServeMux) {
 	mux.HandleFunc("GET /api/v1/upload/books/presigned-url", handler.GetBookPresignedURL)
+	mux.HandleFunc("GET /api/v1/upload/authors/presigned-url", handler.GetAuthorPresignedURL)
 }
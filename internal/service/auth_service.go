@@ -9,4 +9,6 @@ import (
 type AuthService interface {
 	CreateNewUser(ctx context.Context, request web.CreateUserRequest) (web.CreateUserResponse, error)
 	LoginExistingUser(ctx context.Context, request web.LoginRequest) (web.LoginResponse, error)
+	RefreshSession(ctx context.Context, request web.RefreshTokenRequest) (web.RefreshTokenResponse, error)
+	Logout(ctx context.Context, request web.LogoutRequest) error
 }
@@ -2,27 +2,306 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
 	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+	"golang.org/x/crypto/bcrypt"
 )
 
-func NewAuthService(uow UnitOfWork, validate *validator.Validate) AuthService {
+func NewAuthService(uow UnitOfWork, validate *validator.Validate, cfg *config.Config) AuthService {
 	return &AuthServiceImpl{
 		UoW:      uow,
 		Validate: validate,
+		Config:   cfg,
 	}
 }
 
 type AuthServiceImpl struct {
 	UoW      UnitOfWork
 	Validate *validator.Validate
+	Config   *config.Config
 }
 
 func (service *AuthServiceImpl) CreateNewUser(ctx context.Context, request web.CreateUserRequest) (web.CreateUserResponse, error) {
-	return web.CreateUserResponse{}, nil
+	request.FullName = helper.NormalizeName(request.FullName)
+
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.CreateUserResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.CreateUserResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of UserRepository
+	userRepo := tx.GetUserRepository()
+
+	// Check if email already exists
+	err = userRepo.CheckByEmail(ctx, request.Email)
+	if err != nil {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "email",
+			Message: fmt.Sprintf("email %s is already registered", request.Email),
+		})
+	}
+
+	if len(errAggregate) != 0 {
+		return web.CreateUserResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	// Hash the password before storing it
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return web.CreateUserResponse{}, err
+	}
+
+	user := domain.User{
+		FullName: request.FullName,
+		Email:    request.Email,
+		Password: string(hashedPassword),
+	}
+
+	// Call repository
+	user, err = userRepo.Save(ctx, user)
+	if err != nil {
+		return web.CreateUserResponse{}, err
+	}
+
+	return helper.ToCreateUserResponse(user), nil
 }
 
 func (service *AuthServiceImpl) LoginExistingUser(ctx context.Context, request web.LoginRequest) (web.LoginResponse, error) {
-	return web.LoginResponse{}, nil
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.LoginResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.LoginResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of UserRepository
+	userRepo := tx.GetUserRepository()
+
+	// Unknown email and wrong password share the same message,
+	// so the response does not reveal which emails are registered
+	user, err := userRepo.FindByEmail(ctx, request.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "email",
+				Message: "email or password is incorrect",
+			})
+
+			return web.LoginResponse{}, appError.NewAppError(
+				http.StatusUnauthorized,
+				errAggregate,
+				fmt.Errorf("user with email %s is not found", request.Email),
+			)
+		}
+		return web.LoginResponse{}, err
+	}
+
+	// Compare the stored hash with the given password
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
+	if err != nil {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "email",
+			Message: "email or password is incorrect",
+		})
+
+		return web.LoginResponse{}, appError.NewAppError(
+			http.StatusUnauthorized,
+			errAggregate,
+			err,
+		)
+	}
+
+	// Issue a new session for the user
+	session, err := service.issueTokens(ctx, tx.GetRefreshTokenRepository(), user.Id)
+	if err != nil {
+		return web.LoginResponse{}, err
+	}
+
+	loginResponse := helper.ToLoginResponse(user)
+	loginResponse.AccessToken = session.AccessToken
+	loginResponse.AccessTokenExpiresAt = session.AccessTokenExpiresAt
+	loginResponse.RefreshToken = session.RefreshToken
+
+	return loginResponse, nil
+}
+
+func (service *AuthServiceImpl) RefreshSession(ctx context.Context, request web.RefreshTokenRequest) (web.RefreshTokenResponse, error) {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of RefreshTokenRepository
+	refreshTokenRepo := tx.GetRefreshTokenRepository()
+
+	// Rotate the refresh token, it is revoked only if it exists, is not revoked and is not expired.
+	// A token reused by a concurrent refresh gets no row, so only one of them mints a new session
+	refreshToken, err := refreshTokenRepo.RevokeByTokenHash(ctx, helper.HashRefreshToken(request.RefreshToken), time.Now())
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return web.RefreshTokenResponse{}, err
+		}
+
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "refresh_token",
+			Message: "refresh token is invalid or expired",
+		})
+
+		return web.RefreshTokenResponse{}, appError.NewAppError(
+			http.StatusUnauthorized,
+			errAggregate,
+			errors.New("refresh token is invalid or expired"),
+		)
+	}
+
+	session, err := service.issueTokens(ctx, refreshTokenRepo, refreshToken.UserId)
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+
+	return session, nil
+}
+
+func (service *AuthServiceImpl) Logout(ctx context.Context, request web.LogoutRequest) error {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of RefreshTokenRepository
+	refreshTokenRepo := tx.GetRefreshTokenRepository()
+
+	// Unknown, revoked and expired tokens are ignored so logout is idempotent
+	_, err = refreshTokenRepo.RevokeByTokenHash(ctx, helper.HashRefreshToken(request.RefreshToken), time.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// issueTokens signs a new access token and stores a new refresh token for userId
+func (service *AuthServiceImpl) issueTokens(ctx context.Context, refreshTokenRepo repository.RefreshTokenRepository, userId string) (web.RefreshTokenResponse, error) {
+	accessTokenExpiresAt := time.Now().Add(config.AccessTokenPeriod)
+	accessToken, err := helper.GenerateAccessToken(userId, service.Config.AccessTokenSecret, accessTokenExpiresAt)
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+
+	refreshToken, refreshTokenHash, err := helper.GenerateRefreshToken()
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+
+	_, err = refreshTokenRepo.Save(ctx, domain.RefreshToken{
+		UserId:    userId,
+		TokenHash: refreshTokenHash,
+		ExpiresAt: time.Now().Add(config.RefreshTokenPeriod),
+	})
+	if err != nil {
+		return web.RefreshTokenResponse{}, err
+	}
+
+	return web.RefreshTokenResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: accessTokenExpiresAt,
+		RefreshToken:         refreshToken,
+	}, nil
 }
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+// MockRefreshTokenRepository keeps tokens by hash, RevokeByTokenHash is atomic like the UPDATE it stands for
+type MockRefreshTokenRepository struct {
+	repository.RefreshTokenRepository
+
+	mu     sync.Mutex
+	Tokens map[string]*domain.RefreshToken
+}
+
+func (m *MockRefreshTokenRepository) Save(ctx context.Context, refreshToken domain.RefreshToken) (domain.RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	refreshToken.Id = refreshToken.TokenHash
+	m.Tokens[refreshToken.TokenHash] = &refreshToken
+	return refreshToken, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHash string, now time.Time) (domain.RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	refreshToken, ok := m.Tokens[tokenHash]
+	if !ok || refreshToken.RevokedAt != nil || !refreshToken.ExpiresAt.After(now) {
+		return domain.RefreshToken{}, pgx.ErrNoRows
+	}
+
+	refreshToken.RevokedAt = &now
+	return *refreshToken, nil
+}
+
+func TestRefreshSession(t *testing.T) {
+	newService := func(tokens map[string]*domain.RefreshToken) AuthService {
+		refreshTokenRepo := &MockRefreshTokenRepository{Tokens: tokens}
+		return NewAuthService(
+			&MockUnitOfWork{Tx: &MockTransaction{RefreshTokenRepo: refreshTokenRepo}},
+			config.ValidatorInit(),
+			&config.Config{AccessTokenSecret: "secret"},
+		)
+	}
+
+	t.Run("refresh token is rotated once", func(t *testing.T) {
+		token := "first-refresh-token"
+		service := newService(map[string]*domain.RefreshToken{
+			helper.HashRefreshToken(token): {UserId: "user-a", ExpiresAt: time.Now().Add(time.Hour)},
+		})
+
+		session, err := service.RefreshSession(context.Background(), web.RefreshTokenRequest{RefreshToken: token})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if session.RefreshToken == "" || session.RefreshToken == token {
+			t.Errorf("expected a new refresh token but got '%s'", session.RefreshToken)
+		}
+
+		_, err = service.RefreshSession(context.Background(), web.RefreshTokenRequest{RefreshToken: token})
+
+		var appErr *appError.AppError
+		if !errors.As(err, &appErr) || appErr.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected the reused token to be unauthorized but got %v", err)
+		}
+	})
+
+	t.Run("concurrent refreshes with the same token mint one session", func(t *testing.T) {
+		token := "shared-refresh-token"
+		service := newService(map[string]*domain.RefreshToken{
+			helper.HashRefreshToken(token): {UserId: "user-a", ExpiresAt: time.Now().Add(time.Hour)},
+		})
+
+		var wg sync.WaitGroup
+		results := make(chan error, 2)
+		for range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := service.RefreshSession(context.Background(), web.RefreshTokenRequest{RefreshToken: token})
+				results <- err
+			}()
+		}
+		wg.Wait()
+		close(results)
+
+		succeeded := 0
+		for err := range results {
+			if err == nil {
+				succeeded++
+			}
+		}
+
+		if succeeded != 1 {
+			t.Errorf("expected 1 refresh to succeed but got %d", succeeded)
+		}
+	})
+
+	t.Run("expired refresh token", func(t *testing.T) {
+		token := "expired-refresh-token"
+		service := newService(map[string]*domain.RefreshToken{
+			helper.HashRefreshToken(token): {UserId: "user-a", ExpiresAt: time.Now().Add(-time.Minute)},
+		})
+
+		_, err := service.RefreshSession(context.Background(), web.RefreshTokenRequest{RefreshToken: token})
+
+		var appErr *appError.AppError
+		if !errors.As(err, &appErr) || appErr.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected the expired token to be unauthorized but got %v", err)
+		}
+	})
+}
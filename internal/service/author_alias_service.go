@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type AuthorAliasService interface {
+	CreateNewAlias(ctx context.Context, pathValues web.PathParamsAuthorAliases, request web.CreateAuthorAliasRequest) (web.AuthorAliasResponse, error)
+	GetAllAliases(ctx context.Context, pathValues web.PathParamsAuthorAliases) ([]web.AuthorAliasResponse, error)
+	GetAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) (web.AuthorAliasResponse, error)
+	UpdateAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias, request web.UpdateAuthorAliasRequest) (web.AuthorAliasResponse, error)
+	DeleteAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) error
+}
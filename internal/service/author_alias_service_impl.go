@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewAuthorAliasService(uow UnitOfWork, validate *validator.Validate) AuthorAliasService {
+	return &AuthorAliasServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type AuthorAliasServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *AuthorAliasServiceImpl) CreateNewAlias(ctx context.Context, pathValues web.PathParamsAuthorAliases, request web.CreateAuthorAliasRequest) (web.AuthorAliasResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Aliases are stored in NFC like author names
+	request.Name = helper.NormalizeName(request.Name)
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if author exists
+	_, err = findAuthor(ctx, tx, pathValues.AuthorId)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Check if name is already the full_name or an alias of any author
+	err = tx.GetAuthorRepository().CheckByFullName(ctx, request.Name)
+	if err != nil {
+		return web.AuthorAliasResponse{}, aliasNameExistsError(request.Name)
+	}
+
+	alias := domain.AuthorAlias{
+		AuthorId: pathValues.AuthorId,
+		Name:     request.Name,
+	}
+
+	// Call repository
+	alias, err = tx.GetAuthorAliasRepository().Save(ctx, alias)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	return helper.ToAuthorAliasResponse(alias), nil
+}
+
+func (service *AuthorAliasServiceImpl) GetAllAliases(ctx context.Context, pathValues web.PathParamsAuthorAliases) ([]web.AuthorAliasResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.AuthorAliasResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.AuthorAliasResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if author exists
+	_, err = findAuthor(ctx, tx, pathValues.AuthorId)
+	if err != nil {
+		return []web.AuthorAliasResponse{}, err
+	}
+
+	// Call repository
+	aliases, err := tx.GetAuthorAliasRepository().FindAllByAuthorId(ctx, pathValues.AuthorId)
+	if err != nil {
+		return []web.AuthorAliasResponse{}, err
+	}
+
+	return helper.ToAuthorAliasesResponse(aliases), nil
+}
+
+func (service *AuthorAliasServiceImpl) GetAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) (web.AuthorAliasResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if author exists
+	_, err = findAuthor(ctx, tx, pathValues.AuthorId)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Call repository
+	alias, err := tx.GetAuthorAliasRepository().FindById(ctx, pathValues.AuthorId, pathValues.AliasId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.AuthorAliasResponse{}, aliasNotFoundError(pathValues.AliasId)
+		}
+		return web.AuthorAliasResponse{}, err
+	}
+
+	return helper.ToAuthorAliasResponse(alias), nil
+}
+
+func (service *AuthorAliasServiceImpl) UpdateAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias, request web.UpdateAuthorAliasRequest) (web.AuthorAliasResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Aliases are stored in NFC like author names
+	request.Name = helper.NormalizeName(request.Name)
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if author exists
+	_, err = findAuthor(ctx, tx, pathValues.AuthorId)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// It creates a new instance of AuthorAliasRepository
+	aliasRepo := tx.GetAuthorAliasRepository()
+
+	// Check if id is exists
+	alias, err := aliasRepo.FindById(ctx, pathValues.AuthorId, pathValues.AliasId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// If id is not found, return earlier
+			return web.AuthorAliasResponse{}, aliasNotFoundError(pathValues.AliasId)
+		}
+		return web.AuthorAliasResponse{}, err
+	}
+
+	// Check if the new name is already taken, keeping the same name is not a conflict
+	if alias.Name != request.Name {
+		err = tx.GetAuthorRepository().CheckByFullName(ctx, request.Name)
+		if err != nil {
+			return web.AuthorAliasResponse{}, aliasNameExistsError(request.Name)
+		}
+	}
+
+	alias.Name = request.Name
+
+	// Call repository
+	alias, err = aliasRepo.Update(ctx, alias)
+	if err != nil {
+		return web.AuthorAliasResponse{}, err
+	}
+
+	return helper.ToAuthorAliasResponse(alias), nil
+}
+
+func (service *AuthorAliasServiceImpl) DeleteAliasById(ctx context.Context, pathValues web.PathParamsAuthorAlias) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if author exists
+	_, err = findAuthor(ctx, tx, pathValues.AuthorId)
+	if err != nil {
+		return err
+	}
+
+	// It creates a new instance of AuthorAliasRepository
+	aliasRepo := tx.GetAuthorAliasRepository()
+
+	// Check if id is exists
+	_, err = aliasRepo.FindById(ctx, pathValues.AuthorId, pathValues.AliasId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// if id not found, return earlier
+			return aliasNotFoundError(pathValues.AliasId)
+		}
+		return err
+	}
+
+	// Call repository
+	err = aliasRepo.Delete(ctx, pathValues.AliasId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// findAuthor returns an author of the catalog, or a 404 AppError when there is no such author
+func findAuthor(ctx context.Context, tx Transaction, authorId string) (domain.Author, error) {
+	author, err := tx.GetAuthorRepository().FindById(ctx, authorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Author{}, appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("author with id '%s' is not found", authorId),
+					},
+				},
+				fmt.Errorf("author with id '%s' is not found", authorId),
+			)
+		}
+		return domain.Author{}, err
+	}
+
+	return author, nil
+}
+
+func aliasNotFoundError(aliasId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "alias_id",
+				Message: fmt.Sprintf("alias with id '%s' is not found", aliasId),
+			},
+		},
+		fmt.Errorf("alias with id '%s' is not found", aliasId),
+	)
+}
+
+func aliasNameExistsError(name string) error {
+	return appError.NewAppError(
+		http.StatusBadRequest,
+		[]appError.ErrAggregate{
+			{
+				Field:   "name",
+				Message: fmt.Sprintf("author or alias %s is already exists", name),
+			},
+		},
+		nil,
+	)
+}
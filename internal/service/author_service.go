@@ -8,8 +8,9 @@ import (
 
 type AuthorService interface {
 	CreateNewAuthor(ctx context.Context, request web.CreateAuthorRequest) (web.CreateAuthorResponse, error)
-	GetAllAuthors(ctx context.Context, queris web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, error)
+	GetAllAuthors(ctx context.Context, queris web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, web.PaginationMeta, error)
 	GetAuthorById(ctx context.Context, pathValues web.PathParamsGetAuthor) (web.GetAuthorResponse, error)
 	UpdateAuthorById(ctx context.Context, pathValues web.PathParamsUpdateAuthor, request web.UpdateAuthorRequest) (web.UpdateAuthorResponse, error)
-	DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor) error
+	MergeAuthors(ctx context.Context, pathValues web.PathParamsMergeAuthors, request web.MergeAuthorsRequest) (web.MergeAuthorsResponse, error)
+	DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor, queries web.QueryParamsDeleteAuthor) error
 }
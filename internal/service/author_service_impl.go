@@ -5,28 +5,43 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/config"
 	appError "github.com/mhaatha/go-bookshelf/internal/errors"
 	"github.com/mhaatha/go-bookshelf/internal/helper"
 	"github.com/mhaatha/go-bookshelf/internal/model/domain"
 	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+	"github.com/minio/minio-go/v7"
 )
 
-func NewAuthorService(uow UnitOfWork, validate *validator.Validate) AuthorService {
+func NewAuthorService(uow UnitOfWork, validate *validator.Validate, minioClient *minio.Client, cfg *config.Config) AuthorService {
 	return &AuthorServiceImpl{
-		UoW:      uow,
-		Validate: validate,
+		UoW:         uow,
+		Validate:    validate,
+		MinIOClient: minioClient,
+		Config:      cfg,
 	}
 }
 
 type AuthorServiceImpl struct {
-	UoW      UnitOfWork
-	Validate *validator.Validate
+	UoW         UnitOfWork
+	Validate    *validator.Validate
+	MinIOClient *minio.Client
+	Config      *config.Config
 }
 
 func (service *AuthorServiceImpl) CreateNewAuthor(ctx context.Context, request web.CreateAuthorRequest) (web.CreateAuthorResponse, error) {
+	// Names are stored in NFC so the same name typed on different keyboards is one author
+	request.FullName = helper.NormalizeName(request.FullName)
+	request.Nationality = helper.NormalizeName(request.Nationality)
+
 	// Validate request body
 	err := service.Validate.Struct(request)
 	if err != nil {
@@ -65,6 +80,14 @@ func (service *AuthorServiceImpl) CreateNewAuthor(ctx context.Context, request w
 		})
 	}
 
+	// Check if the external identifiers already belong to another author
+	errAggregate = append(errAggregate, externalIdErrors(ctx, authorRepo, "", request.OpenLibraryId, request.WikidataId)...)
+
+	// Check if the portrait already belongs to another author
+	errAggregate = append(errAggregate, photoKeyErrors(ctx, authorRepo, "", request.PhotoKey)...)
+
+	errAggregate = append(errAggregate, lifeDateErrors(request.BirthDate, request.DeathDate, time.Now())...)
+
 	if len(errAggregate) != 0 {
 		return web.CreateAuthorResponse{}, appError.NewAppError(
 			http.StatusBadRequest,
@@ -74,8 +97,15 @@ func (service *AuthorServiceImpl) CreateNewAuthor(ctx context.Context, request w
 	}
 
 	author := domain.Author{
-		FullName:    request.FullName,
-		Nationality: request.Nationality,
+		FullName:      request.FullName,
+		Nationality:   request.Nationality,
+		BirthDate:     request.BirthDate,
+		DeathDate:     request.DeathDate,
+		Bio:           request.Bio,
+		Website:       request.Website,
+		OpenLibraryId: request.OpenLibraryId,
+		WikidataId:    request.WikidataId,
+		PhotoKey:      request.PhotoKey,
 	}
 
 	// Call repository
@@ -87,17 +117,21 @@ func (service *AuthorServiceImpl) CreateNewAuthor(ctx context.Context, request w
 	return helper.ToCreateAuthorResponse(author), nil
 }
 
-func (service *AuthorServiceImpl) GetAllAuthors(ctx context.Context, queries web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, error) {
+func (service *AuthorServiceImpl) GetAllAuthors(ctx context.Context, queries web.QueryParamsGetAuthors) ([]web.GetAuthorResponse, web.PaginationMeta, error) {
+	// Filters are compared with the names as stored, in NFC
+	queries.FullName = helper.NormalizeName(queries.FullName)
+	queries.Nationality = helper.NormalizeName(queries.Nationality)
+
 	// Validate queries
 	err := service.Validate.Struct(queries)
 	if err != nil {
-		return []web.GetAuthorResponse{}, err
+		return []web.GetAuthorResponse{}, web.PaginationMeta{}, err
 	}
 
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
-		return nil, err
+		return nil, web.PaginationMeta{}, err
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -114,18 +148,29 @@ func (service *AuthorServiceImpl) GetAllAuthors(ctx context.Context, queries web
 	// It creates a new instance of AuthorRepository
 	authorRepo := tx.GetAuthorRepository()
 
+	// Authors are listed alphabetically unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "name")
+
 	// Call repository
-	authors, err := authorRepo.FindAll(ctx, queries.FullName, queries.Nationality)
+	authors, total, err := authorRepo.FindAll(ctx, queries.FullName, queries.Nationality, page)
 	if err != nil {
-		return []web.GetAuthorResponse{}, err
+		return []web.GetAuthorResponse{}, web.PaginationMeta{}, err
 	}
 
 	// No records return []
 	if len(authors) == 0 {
-		return []web.GetAuthorResponse{}, nil
+		return []web.GetAuthorResponse{}, helper.ToPaginationMeta(page, total), nil
+	}
+
+	authorResponses := helper.ToGetAuthorsResponse(authors)
+	for i, author := range authors {
+		authorResponses[i].PhotoURL, err = service.photoURL(ctx, author.PhotoKey)
+		if err != nil {
+			return []web.GetAuthorResponse{}, web.PaginationMeta{}, err
+		}
 	}
 
-	return helper.ToGetAuthorsResponse(authors), nil
+	return authorResponses, helper.ToPaginationMeta(page, total), nil
 }
 
 func (service *AuthorServiceImpl) GetAuthorById(ctx context.Context, pathValues web.PathParamsGetAuthor) (web.GetAuthorResponse, error) {
@@ -176,7 +221,15 @@ func (service *AuthorServiceImpl) GetAuthorById(ctx context.Context, pathValues
 		return web.GetAuthorResponse{}, err
 	}
 
-	return helper.ToGetAuthorResponse(author), nil
+	authorResponse := helper.ToGetAuthorResponse(author)
+
+	// Presign the portrait
+	authorResponse.PhotoURL, err = service.photoURL(ctx, author.PhotoKey)
+	if err != nil {
+		return web.GetAuthorResponse{}, err
+	}
+
+	return authorResponse, nil
 }
 
 func (service *AuthorServiceImpl) UpdateAuthorById(ctx context.Context, pathValues web.PathParamsUpdateAuthor, request web.UpdateAuthorRequest) (web.UpdateAuthorResponse, error) {
@@ -186,16 +239,33 @@ func (service *AuthorServiceImpl) UpdateAuthorById(ctx context.Context, pathValu
 		return web.UpdateAuthorResponse{}, err
 	}
 
+	// Names are stored in NFC so the same name typed on different keyboards is one author
+	request.FullName = helper.NormalizeName(request.FullName)
+	request.Nationality = helper.NormalizeName(request.Nationality)
+
 	// Validate request body
 	err = service.Validate.Struct(request)
 	if err != nil {
 		return web.UpdateAuthorResponse{}, err
 	}
 
+	author, replacedPortraitKeys, err := service.updateAuthor(ctx, pathValues.Id, request)
+	if err != nil {
+		return web.UpdateAuthorResponse{}, err
+	}
+
+	// The replaced portrait is removed once the update is committed
+	service.removePortraits(ctx, replacedPortraitKeys...)
+
+	return helper.ToUpdateAuthorResponse(author), nil
+}
+
+// updateAuthor updates the author in one transaction, it returns the replaced portrait key when no author uses it anymore
+func (service *AuthorServiceImpl) updateAuthor(ctx context.Context, id string, request web.UpdateAuthorRequest) (author domain.Author, replacedPortraitKeys []string, err error) {
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
-		return web.UpdateAuthorResponse{}, err
+		return domain.Author{}, nil, err
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -216,24 +286,25 @@ func (service *AuthorServiceImpl) UpdateAuthorById(ctx context.Context, pathValu
 	authorRepo := tx.GetAuthorRepository()
 
 	// Check if id is exists
-	author, err := authorRepo.FindById(ctx, pathValues.Id)
+	author, err = authorRepo.FindById(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			errAggregate = append(errAggregate, appError.ErrAggregate{
 				Field:   "id",
-				Message: fmt.Sprintf("author with id '%s' is not found", pathValues.Id),
+				Message: fmt.Sprintf("author with id '%s' is not found", id),
 			})
 
 			// If id is not found, return earlier
-			return web.UpdateAuthorResponse{}, appError.NewAppError(
+			return domain.Author{}, nil, appError.NewAppError(
 				http.StatusNotFound,
 				errAggregate,
-				fmt.Errorf("author with id '%v' is not found", pathValues.Id),
+				fmt.Errorf("author with id '%v' is not found", id),
 			)
 		} else {
-			return web.UpdateAuthorResponse{}, err
+			return domain.Author{}, nil, err
 		}
 	}
+	previousPhotoKey := author.PhotoKey
 
 	// Check if full_name already exists
 	err = authorRepo.CheckByFullName(ctx, request.FullName)
@@ -246,8 +317,16 @@ func (service *AuthorServiceImpl) UpdateAuthorById(ctx context.Context, pathValu
 		}
 	}
 
+	// Check if the external identifiers already belong to another author
+	errAggregate = append(errAggregate, externalIdErrors(ctx, authorRepo, author.Id, request.OpenLibraryId, request.WikidataId)...)
+
+	// Check if the portrait already belongs to another author
+	errAggregate = append(errAggregate, photoKeyErrors(ctx, authorRepo, author.Id, request.PhotoKey)...)
+
+	errAggregate = append(errAggregate, lifeDateErrors(request.BirthDate, request.DeathDate, time.Now())...)
+
 	if len(errAggregate) != 0 {
-		return web.UpdateAuthorResponse{}, appError.NewAppError(
+		return domain.Author{}, nil, appError.NewAppError(
 			http.StatusBadRequest,
 			errAggregate,
 			nil,
@@ -255,31 +334,83 @@ func (service *AuthorServiceImpl) UpdateAuthorById(ctx context.Context, pathValu
 	}
 
 	author = domain.Author{
-		Id:          pathValues.Id,
-		FullName:    request.FullName,
-		Nationality: request.Nationality,
+		Id:            id,
+		FullName:      request.FullName,
+		Nationality:   request.Nationality,
+		BirthDate:     request.BirthDate,
+		DeathDate:     request.DeathDate,
+		Bio:           request.Bio,
+		Website:       request.Website,
+		OpenLibraryId: request.OpenLibraryId,
+		WikidataId:    request.WikidataId,
+		PhotoKey:      request.PhotoKey,
 	}
 
 	// Call repository
-	author, err = authorRepo.Update(ctx, pathValues.Id, author)
+	author, err = authorRepo.Update(ctx, id, author)
 	if err != nil {
-		return web.UpdateAuthorResponse{}, err
+		return domain.Author{}, nil, err
 	}
 
-	return helper.ToUpdateAuthorResponse(author), nil
+	if previousPhotoKey != author.PhotoKey {
+		replacedPortraitKeys = unusedPortraitKeys(ctx, authorRepo, previousPhotoKey)
+	}
+
+	return author, replacedPortraitKeys, nil
 }
 
-func (service *AuthorServiceImpl) DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor) error {
+func (service *AuthorServiceImpl) MergeAuthors(ctx context.Context, pathValues web.PathParamsMergeAuthors, request web.MergeAuthorsRequest) (web.MergeAuthorsResponse, error) {
 	// Validate path params
 	err := service.Validate.Struct(pathValues)
 	if err != nil {
-		return err
+		return web.MergeAuthorsResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.MergeAuthorsResponse{}, err
+	}
+
+	// Get the authenticated user, only their own books are reported as collisions
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.MergeAuthorsResponse{}, err
+	}
+
+	// An author cannot be merged into itself
+	if slices.Contains(request.DuplicateIds, pathValues.Id) {
+		return web.MergeAuthorsResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "duplicate_ids",
+					Message: fmt.Sprintf("duplicate_ids must not contain the surviving author '%s'", pathValues.Id),
+				},
+			},
+			nil,
+		)
 	}
 
+	response, portraitKeys, photoKeys, err := service.mergeAuthors(ctx, userId, pathValues.Id, request.DuplicateIds)
+	if err != nil {
+		return web.MergeAuthorsResponse{}, err
+	}
+
+	// Portraits of the duplicates and covers of the merged books are removed once they are gone for good
+	service.removePortraits(ctx, portraitKeys...)
+	service.removeCovers(ctx, photoKeys...)
+
+	return response, nil
+}
+
+// mergeAuthors merges the duplicates into the author in one transaction, it returns the merged author, the portrait
+// keys of the deleted duplicates and the photo keys of the books merged into another book
+func (service *AuthorServiceImpl) mergeAuthors(ctx context.Context, userId, authorId string, duplicateIds []string) (response web.MergeAuthorsResponse, portraitKeys []string, photoKeys []string, err error) {
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
-		return err
+		return web.MergeAuthorsResponse{}, nil, nil, err
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -293,37 +424,450 @@ func (service *AuthorServiceImpl) DeleteAuthorById(ctx context.Context, pathValu
 		}
 	}()
 
-	// errAggregate aggregates errors from user bad request
-	errAggregate := []appError.ErrAggregate{}
-
-	// It creates a new instance of AuthorRepository
+	// It creates new instances of AuthorRepository, AuthorAliasRepository and BookRepository
 	authorRepo := tx.GetAuthorRepository()
+	aliasRepo := tx.GetAuthorAliasRepository()
+	bookRepo := tx.GetBookRepository()
 
-	// Check if id is exists
-	_, err = authorRepo.FindById(ctx, pathValues.Id)
+	// Check if the surviving author exists
+	author, err := authorRepo.FindById(ctx, authorId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			errAggregate = append(errAggregate, appError.ErrAggregate{
-				Field:   "id",
-				Message: fmt.Sprintf("author with id '%s' is not found", pathValues.Id),
+			return web.MergeAuthorsResponse{}, nil, nil, appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("author with id '%s' is not found", authorId),
+					},
+				},
+				fmt.Errorf("author with id '%v' is not found", authorId),
+			)
+		}
+		return web.MergeAuthorsResponse{}, nil, nil, err
+	}
+
+	// Check if every duplicate exists
+	notFound := []appError.ErrAggregate{}
+	duplicates := make([]domain.Author, 0, len(duplicateIds))
+	for _, duplicateId := range duplicateIds {
+		var duplicate domain.Author
+		duplicate, err = authorRepo.FindById(ctx, duplicateId)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return web.MergeAuthorsResponse{}, nil, nil, err
+			}
+
+			notFound = append(notFound, appError.ErrAggregate{
+				Field:   "duplicate_ids",
+				Message: fmt.Sprintf("author with id '%s' is not found", duplicateId),
 			})
+			continue
+		}
+
+		duplicates = append(duplicates, duplicate)
+	}
+
+	if len(notFound) != 0 {
+		err = appError.NewAppError(
+			http.StatusNotFound,
+			notFound,
+			nil,
+		)
+		return web.MergeAuthorsResponse{}, nil, nil, err
+	}
+
+	// Books of any owner with the same name would end up with the same author, the books are moved for every owner
+	// so their collisions are found for every owner too
+	collisions, err := bookRepo.FindCollisionsByAuthorIds(ctx, append([]string{author.Id}, duplicateIds...))
+	if err != nil {
+		return web.MergeAuthorsResponse{}, nil, nil, err
+	}
+
+	for _, duplicate := range duplicates {
+		// Move the books, series and aliases of the duplicate, a book both authors contribute to keeps one of them
+		err = bookRepo.MergeAuthor(ctx, duplicate.Id, author.Id)
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		err = tx.GetSeriesRepository().MergeAuthor(ctx, duplicate.Id, author.Id)
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		err = aliasRepo.ReassignAuthor(ctx, duplicate.Id, author.Id)
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		// The name of the duplicate stays known as an alias of the surviving author
+		_, err = aliasRepo.Save(ctx, domain.AuthorAlias{
+			AuthorId: author.Id,
+			Name:     duplicate.FullName,
+		})
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		err = authorRepo.Delete(ctx, duplicate.Id)
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+	}
+
+	// The colliding books become one, the first added keeps everything the others had. Only the collisions of the
+	// user are reported, other owners find their books merged
+	ownCollisions := []domain.BookCollision{}
+	for _, collision := range collisions {
+		var coverKeys []string
+		coverKeys, err = bookRepo.MergeBooks(ctx, collision.BookIds[0], collision.BookIds[1:])
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+		photoKeys = append(photoKeys, coverKeys...)
+
+		var book domain.Book
+		book, err = bookRepo.FindById(ctx, collision.OwnerId, collision.BookIds[0])
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		// The status follows the reads the book has now
+		_, err = syncBookWithReads(ctx, tx, collision.OwnerId, book)
+		if err != nil {
+			return web.MergeAuthorsResponse{}, nil, nil, err
+		}
+
+		if collision.OwnerId == userId {
+			ownCollisions = append(ownCollisions, collision)
+		}
+	}
+
+	aliases, err := aliasRepo.FindAllByAuthorId(ctx, author.Id)
+	if err != nil {
+		return web.MergeAuthorsResponse{}, nil, nil, err
+	}
 
+	// The portraits of the duplicates go with them, unless another author uses the same one
+	for _, duplicate := range duplicates {
+		portraitKeys = append(portraitKeys, duplicate.PhotoKey)
+	}
+	portraitKeys = unusedPortraitKeys(ctx, authorRepo, portraitKeys...)
+
+	return helper.ToMergeAuthorsResponse(author, aliases, duplicateIds, ownCollisions), portraitKeys, photoKeys, nil
+}
+
+func (service *AuthorServiceImpl) DeleteAuthorById(ctx context.Context, pathValues web.PathParamsDeleteAuthor, queries web.QueryParamsDeleteAuthor) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Validate query params
+	err = service.Validate.Struct(queries)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, only their own books are touched
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// An author cannot take over their own books
+	if queries.ReassignTo != "" && queries.ReassignTo == pathValues.Id {
+		return appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "reassign_to",
+					Message: "reassign_to must be another author",
+				},
+			},
+			nil,
+		)
+	}
+
+	portraitKeys, photoKeys, err := service.deleteAuthor(ctx, userId, pathValues.Id, queries)
+	if err != nil {
+		return err
+	}
+
+	service.removePortraits(ctx, portraitKeys...)
+
+	// Covers are removed once the books are gone for good
+	service.removeCovers(ctx, photoKeys...)
+
+	return nil
+}
+
+// deleteAuthor deletes the author and deals with the books of the owner in one transaction, the books of other owners
+// are never touched and keep the author in the catalog. It returns the portrait key of the author when no other
+// author uses it and the photo keys of the books deleted with the author
+func (service *AuthorServiceImpl) deleteAuthor(ctx context.Context, userId, authorId string, queries web.QueryParamsDeleteAuthor) (portraitKeys []string, photoKeys []string, err error) {
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates new instances of AuthorRepository and BookRepository
+	authorRepo := tx.GetAuthorRepository()
+	bookRepo := tx.GetBookRepository()
+
+	// Check if id is exists
+	author, err := authorRepo.FindById(ctx, authorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
 			// if id not found, return earlier
-			return appError.NewAppError(
+			return nil, nil, appError.NewAppError(
 				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("author with id '%s' is not found", authorId),
+					},
+				},
+				fmt.Errorf("author with id '%v' is not found", authorId),
+			)
+		}
+		return nil, nil, err
+	}
+
+	switch {
+	case queries.ReassignTo != "":
+		// Check if the author taking over the books exists
+		_, err = authorRepo.FindById(ctx, queries.ReassignTo)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, appError.NewAppError(
+					http.StatusNotFound,
+					[]appError.ErrAggregate{
+						{
+							Field:   "reassign_to",
+							Message: fmt.Sprintf("author with id '%s' is not found", queries.ReassignTo),
+						},
+					},
+					fmt.Errorf("author with id '%v' is not found", queries.ReassignTo),
+				)
+			}
+			return nil, nil, err
+		}
+
+		err = bookRepo.ReassignAuthor(ctx, userId, authorId, queries.ReassignTo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = tx.GetSeriesRepository().ReassignAuthor(ctx, userId, authorId, queries.ReassignTo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = tx.GetAuthorAliasRepository().ReassignAuthor(ctx, authorId, queries.ReassignTo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case queries.Mode == domain.AuthorDeleteModeCascade:
+		photoKeys, err = bookRepo.DeleteAllByAuthorId(ctx, userId, authorId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	default:
+		// Books block the deletion unless the caller says what happens to them
+		var bookIds []string
+		bookIds, err = bookRepo.FindIdsByAuthorId(ctx, userId, authorId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(bookIds) != 0 {
+			errAggregate := make([]appError.ErrAggregate, 0, len(bookIds))
+			for _, bookId := range bookIds {
+				errAggregate = append(errAggregate, appError.ErrAggregate{
+					Field:   "book_id",
+					Message: fmt.Sprintf("author is a contributor of book with id '%s', use mode=cascade or reassign_to", bookId),
+				})
+			}
+
+			return nil, nil, appError.NewAppError(
+				http.StatusConflict,
 				errAggregate,
-				fmt.Errorf("author with id '%v' is not found", pathValues.Id),
+				fmt.Errorf("author with id '%v' has %d books", authorId, len(bookIds)),
 			)
-		} else {
-			return err
 		}
 	}
 
+	// The author stays in the catalog while other users have books by them, whatever was done to the books
+	// of the owner is rolled back
+	otherBooks, err := bookRepo.CountOfOtherOwnersByAuthorId(ctx, userId, authorId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if otherBooks != 0 {
+		return nil, nil, appError.NewAppError(
+			http.StatusConflict,
+			[]appError.ErrAggregate{
+				{
+					Field:   "id",
+					Message: "author is a contributor of books of other users and can not be deleted",
+				},
+			},
+			fmt.Errorf("author with id '%v' has %d books of other users", authorId, otherBooks),
+		)
+	}
+
 	// Call repository
-	err = authorRepo.Delete(ctx, pathValues.Id)
+	err = authorRepo.Delete(ctx, authorId)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	return unusedPortraitKeys(ctx, authorRepo, author.PhotoKey), photoKeys, nil
+}
+
+// unusedPortraitKeys keeps the photo keys no author points to anymore. A portrait another author still uses stays in
+// storage, and so does any key outside the portrait prefix since the author bucket may be the book bucket
+func unusedPortraitKeys(ctx context.Context, authorRepo repository.AuthorRepository, photoKeys ...string) []string {
+	unused := []string{}
+	for _, photoKey := range photoKeys {
+		if !strings.HasPrefix(photoKey, domain.AuthorPhotoKeyPrefix) || slices.Contains(unused, photoKey) {
+			continue
+		}
+
+		// A failed check keeps the portrait, a portrait left behind is only wasted storage
+		if err := authorRepo.CheckByPhotoKey(ctx, "", photoKey); err != nil {
+			continue
+		}
+
+		unused = append(unused, photoKey)
+	}
+
+	return unused
+}
+
+// removePortraits removes portraits returned by unusedPortraitKeys once the transaction is committed
+func (service *AuthorServiceImpl) removePortraits(ctx context.Context, photoKeys ...string) {
+	for _, photoKey := range photoKeys {
+		err := service.MinIOClient.RemoveObject(ctx, service.Config.AuthorBucket, photoKey, minio.RemoveObjectOptions{})
+		if err != nil {
+			slog.Warn("failed to remove author portrait", "photo_key", photoKey, "err", err)
+		}
+	}
+}
+
+// removeCovers removes the covers of deleted books once the transaction is committed, a cover left behind is only
+// wasted storage
+func (service *AuthorServiceImpl) removeCovers(ctx context.Context, photoKeys ...string) {
+	for _, photoKey := range photoKeys {
+		err := service.MinIOClient.RemoveObject(ctx, service.Config.BookBucket, photoKey, minio.RemoveObjectOptions{})
+		if err != nil {
+			slog.Warn("failed to remove book cover", "photo_key", photoKey, "err", err)
+		}
+	}
+}
+
+// photoURL presigns the portrait of an author, authors without a portrait get an empty URL
+func (service *AuthorServiceImpl) photoURL(ctx context.Context, photoKey string) (string, error) {
+	if photoKey == "" {
+		return "", nil
+	}
+
+	presignedURL, err := service.MinIOClient.PresignedGetObject(ctx, service.Config.AuthorBucket, photoKey, 24*time.Hour, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return presignedURL.String(), nil
+}
+
+// externalIdErrors checks that the Open Library and Wikidata IDs do not belong to an author other than authorId
+func externalIdErrors(ctx context.Context, authorRepo repository.AuthorRepository, authorId, openLibraryId, wikidataId string) []appError.ErrAggregate {
+	errAggregate := []appError.ErrAggregate{}
+
+	for _, externalId := range []struct{ column, value string }{
+		{"open_library_id", openLibraryId},
+		{"wikidata_id", wikidataId},
+	} {
+		if externalId.value == "" {
+			continue
+		}
+
+		if err := authorRepo.CheckByExternalId(ctx, authorId, externalId.column, externalId.value); err != nil {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   externalId.column,
+				Message: fmt.Sprintf("author with %s '%s' is already exists", externalId.column, externalId.value),
+			})
+		}
+	}
+
+	return errAggregate
+}
+
+// photoKeyErrors checks that the portrait does not belong to an author other than authorId, removing it with that
+// author would leave this one without a portrait
+func photoKeyErrors(ctx context.Context, authorRepo repository.AuthorRepository, authorId, photoKey string) []appError.ErrAggregate {
+	if photoKey == "" {
+		return nil
+	}
+
+	if err := authorRepo.CheckByPhotoKey(ctx, authorId, photoKey); err != nil {
+		return []appError.ErrAggregate{
+			{
+				Field:   "photo_key",
+				Message: fmt.Sprintf("author with photo_key '%s' is already exists", photoKey),
+			},
+		}
 	}
 
 	return nil
 }
+
+// lifeDateErrors checks birth_date and death_date as a pair, either may be unknown but neither is in the future
+// and an author does not die before they are born
+func lifeDateErrors(birthDate, deathDate string, now time.Time) []appError.ErrAggregate {
+	errAggregate := []appError.ErrAggregate{}
+
+	// Dates are already validated as YYYY-MM-DD so they compare as strings
+	today := now.Format("2006-01-02")
+
+	if birthDate > today {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "birth_date",
+			Message: "birth_date must not be in the future",
+		})
+	}
+
+	if deathDate > today {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "death_date",
+			Message: "death_date must not be in the future",
+		})
+	}
+
+	if birthDate != "" && deathDate != "" && deathDate < birthDate {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "death_date",
+			Message: "death_date must not be before birth_date",
+		})
+	}
+
+	return errAggregate
+}
@@ -0,0 +1,664 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func (m *MockAuthorRepository) FindById(ctx context.Context, authorId string) (domain.Author, error) {
+	author, ok := m.Authors[authorId]
+	if !ok {
+		return domain.Author{}, pgx.ErrNoRows
+	}
+
+	return author, nil
+}
+
+func (m *MockAuthorRepository) CheckByFullName(ctx context.Context, fullName string) error {
+	for _, author := range m.Authors {
+		if author.FullName == fullName {
+			return errors.New("author is already exists")
+		}
+	}
+
+	return nil
+}
+
+func (m *MockAuthorRepository) CheckByExternalId(ctx context.Context, authorId, column, value string) error {
+	return nil
+}
+
+func (m *MockAuthorRepository) CheckByPhotoKey(ctx context.Context, authorId, photoKey string) error {
+	for _, author := range m.Authors {
+		if author.Id != authorId && author.PhotoKey == photoKey {
+			return errors.New("author is already exists")
+		}
+	}
+
+	return nil
+}
+
+func (m *MockAuthorRepository) Update(ctx context.Context, authorId string, author domain.Author) (domain.Author, error) {
+	m.Authors[authorId] = author
+
+	return author, nil
+}
+
+func (m *MockAuthorRepository) Delete(ctx context.Context, authorId string) error {
+	delete(m.Authors, authorId)
+
+	return nil
+}
+
+func hasContributor(book domain.Book, authorId string) bool {
+	return slices.ContainsFunc(book.Contributors, func(contributor domain.BookContributor) bool {
+		return contributor.AuthorId == authorId
+	})
+}
+
+func (m *MockBookRepository) FindIdsByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error) {
+	bookIds := []string{}
+	for _, book := range m.Books {
+		if book.OwnerId == ownerId && hasContributor(book, authorId) {
+			bookIds = append(bookIds, book.Id)
+		}
+	}
+	slices.Sort(bookIds)
+
+	return bookIds, nil
+}
+
+func (m *MockBookRepository) CountOfOtherOwnersByAuthorId(ctx context.Context, ownerId, authorId string) (int, error) {
+	total := 0
+	for _, book := range m.Books {
+		if book.OwnerId != ownerId && hasContributor(book, authorId) {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+func (m *MockBookRepository) DeleteAllByAuthorId(ctx context.Context, ownerId, authorId string) ([]string, error) {
+	for id, book := range m.Books {
+		if book.OwnerId != ownerId || !hasContributor(book, authorId) {
+			continue
+		}
+
+		book.Contributors = slices.DeleteFunc(slices.Clone(book.Contributors), func(contributor domain.BookContributor) bool {
+			return contributor.AuthorId == authorId
+		})
+		if len(book.Contributors) == 0 {
+			delete(m.Books, id)
+		} else {
+			m.Books[id] = book
+		}
+	}
+
+	return []string{}, nil
+}
+
+func (m *MockBookRepository) ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error {
+	for id, book := range m.Books {
+		if book.OwnerId != ownerId {
+			continue
+		}
+
+		book.Contributors = slices.Clone(book.Contributors)
+		for i := range book.Contributors {
+			if book.Contributors[i].AuthorId == fromAuthorId {
+				book.Contributors[i].AuthorId = toAuthorId
+			}
+		}
+		m.Books[id] = book
+	}
+
+	return nil
+}
+
+func (m *MockSeriesRepository) ReassignAuthor(ctx context.Context, ownerId, fromAuthorId, toAuthorId string) error {
+	return nil
+}
+
+func (m *MockBookRepository) FindCollisionsByAuthorIds(ctx context.Context, authorIds []string) ([]domain.BookCollision, error) {
+	type key struct{ ownerId, name string }
+	bookIds := map[key][]string{}
+	authors := map[key]map[string]bool{}
+	for _, book := range m.Books {
+		k := key{book.OwnerId, book.Name}
+		for _, contributor := range book.Contributors {
+			if slices.Contains(authorIds, contributor.AuthorId) {
+				if authors[k] == nil {
+					authors[k] = map[string]bool{}
+				}
+				authors[k][contributor.AuthorId] = true
+				if !slices.Contains(bookIds[k], book.Id) {
+					bookIds[k] = append(bookIds[k], book.Id)
+				}
+			}
+		}
+	}
+
+	collisions := []domain.BookCollision{}
+	for k, ids := range bookIds {
+		if len(ids) > 1 && len(authors[k]) > 1 {
+			slices.Sort(ids)
+			collisions = append(collisions, domain.BookCollision{OwnerId: k.ownerId, Name: k.name, BookIds: ids})
+		}
+	}
+	slices.SortFunc(collisions, func(a, b domain.BookCollision) int {
+		return strings.Compare(a.OwnerId, b.OwnerId)
+	})
+
+	return collisions, nil
+}
+
+func (m *MockBookRepository) MergeBooks(ctx context.Context, bookId string, mergedIds []string) ([]string, error) {
+	photoKeys := []string{}
+	for _, mergedId := range mergedIds {
+		if photoKey := m.Books[mergedId].PhotoKey; photoKey != "" {
+			photoKeys = append(photoKeys, photoKey)
+		}
+		delete(m.Books, mergedId)
+	}
+
+	return photoKeys, nil
+}
+
+func (m *MockBookRepository) MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	for id, book := range m.Books {
+		book.Contributors = slices.Clone(book.Contributors)
+		for i := range book.Contributors {
+			if book.Contributors[i].AuthorId == fromAuthorId {
+				book.Contributors[i].AuthorId = toAuthorId
+			}
+		}
+		m.Books[id] = book
+	}
+
+	return nil
+}
+
+func (m *MockSeriesRepository) MergeAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	return nil
+}
+
+type MockAuthorAliasRepository struct {
+	repository.AuthorAliasRepository
+
+	Aliases []domain.AuthorAlias
+}
+
+func (m *MockAuthorAliasRepository) ReassignAuthor(ctx context.Context, fromAuthorId, toAuthorId string) error {
+	return nil
+}
+
+func (m *MockAuthorAliasRepository) Save(ctx context.Context, alias domain.AuthorAlias) (domain.AuthorAlias, error) {
+	m.Aliases = append(m.Aliases, alias)
+
+	return alias, nil
+}
+
+func (m *MockAuthorAliasRepository) FindAllByAuthorId(ctx context.Context, authorId string) ([]domain.AuthorAlias, error) {
+	return m.Aliases, nil
+}
+
+func TestDeleteAuthorOwnerScope(t *testing.T) {
+	const (
+		sharedAuthorId = "c512ae16-5f33-4a3c-a1e1-977bd5a20af3"
+		coAuthorId     = "e2f1d0c9-b8a7-4c6d-9e5f-4a3b2c1d0e9f"
+	)
+
+	soloBookOfA := domain.Book{
+		Id:           "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+		OwnerId:      ownerA,
+		Contributors: []domain.BookContributor{{AuthorId: sharedAuthorId, Role: domain.ContributorRoleAuthor}},
+	}
+	coWrittenBookOfA := domain.Book{
+		Id:      "2b3c4d5e-6f7a-4b9c-8d1e-2f3a4b5c6d7e",
+		OwnerId: ownerA,
+		Contributors: []domain.BookContributor{
+			{AuthorId: sharedAuthorId, Role: domain.ContributorRoleAuthor},
+			{AuthorId: coAuthorId, Role: domain.ContributorRoleAuthor, Position: 1},
+		},
+	}
+	bookOfB := domain.Book{
+		Id:           "3c4d5e6f-7a8b-4c0d-9e2f-3a4b5c6d7e8f",
+		OwnerId:      ownerB,
+		Contributors: []domain.BookContributor{{AuthorId: sharedAuthorId, Role: domain.ContributorRoleAuthor}},
+	}
+
+	newService := func(books ...domain.Book) (AuthorService, *MockTransaction, *MockBookRepository, *MockAuthorRepository) {
+		bookRepo := &MockBookRepository{Books: map[string]domain.Book{}}
+		for _, book := range books {
+			bookRepo.Books[book.Id] = book
+		}
+		authorRepo := &MockAuthorRepository{Authors: map[string]domain.Author{
+			sharedAuthorId: {Id: sharedAuthorId, FullName: "Leila S. Chudori"},
+			coAuthorId:     {Id: coAuthorId, FullName: "Laksmi Pamuntjak"},
+		}}
+		tx := &MockTransaction{
+			AuthorRepo:      authorRepo,
+			BookRepo:        bookRepo,
+			SeriesRepo:      &MockSeriesRepository{},
+			AuthorAliasRepo: &MockAuthorAliasRepository{},
+		}
+		return NewAuthorService(&MockUnitOfWork{Tx: tx}, config.ValidatorInit(), nil, &config.Config{}), tx, bookRepo, authorRepo
+	}
+
+	ctxA := helper.WithUserId(context.Background(), ownerA)
+	pathValues := web.PathParamsDeleteAuthor{Id: sharedAuthorId}
+
+	t.Run("restrict lists only the books of the caller", func(t *testing.T) {
+		service, _, _, _ := newService(soloBookOfA, coWrittenBookOfA, bookOfB)
+
+		err := service.DeleteAuthorById(ctxA, pathValues, web.QueryParamsDeleteAuthor{})
+		expectStatus(t, err, http.StatusConflict)
+
+		appErr, _ := err.(*appError.AppError)
+		if appErr == nil || len(appErr.ErrAggregate) != 2 {
+			t.Fatalf("expected the 2 books of the caller but got %v", err)
+		}
+		for _, errAggregate := range appErr.ErrAggregate {
+			if strings.Contains(errAggregate.Message, bookOfB.Id) {
+				t.Errorf("expected the book of the other owner to be left out but got '%s'", errAggregate.Message)
+			}
+		}
+	})
+
+	t.Run("restrict without books of the caller", func(t *testing.T) {
+		service, _, _, authorRepo := newService(bookOfB)
+
+		err := service.DeleteAuthorById(ctxA, pathValues, web.QueryParamsDeleteAuthor{})
+		expectStatus(t, err, http.StatusConflict)
+
+		if strings.Contains(err.Error(), bookOfB.Id) {
+			t.Errorf("expected the book of the other owner to be left out but got '%v'", err)
+		}
+		if _, ok := authorRepo.Authors[sharedAuthorId]; !ok {
+			t.Error("expected the author of the other owner's book to be kept")
+		}
+	})
+
+	t.Run("cascade while another owner has books", func(t *testing.T) {
+		service, tx, bookRepo, _ := newService(soloBookOfA, bookOfB)
+
+		err := service.DeleteAuthorById(ctxA, pathValues, web.QueryParamsDeleteAuthor{Mode: domain.AuthorDeleteModeCascade})
+		expectStatus(t, err, http.StatusConflict)
+
+		if !tx.RolledBack.Load() {
+			t.Error("expected the transaction to be rolled back")
+		}
+		if book, ok := bookRepo.Books[bookOfB.Id]; !ok || !hasContributor(book, sharedAuthorId) {
+			t.Error("expected the book of the other owner to be untouched")
+		}
+	})
+
+	t.Run("cascade drops the contributor of a co-written book", func(t *testing.T) {
+		otherBookOfB := bookOfB
+		otherBookOfB.Contributors = []domain.BookContributor{{AuthorId: coAuthorId, Role: domain.ContributorRoleAuthor}}
+		service, _, bookRepo, authorRepo := newService(soloBookOfA, coWrittenBookOfA, otherBookOfB)
+
+		err := service.DeleteAuthorById(ctxA, pathValues, web.QueryParamsDeleteAuthor{Mode: domain.AuthorDeleteModeCascade})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if _, ok := bookRepo.Books[soloBookOfA.Id]; ok {
+			t.Error("expected the book written by the author alone to be deleted")
+		}
+		if book, ok := bookRepo.Books[coWrittenBookOfA.Id]; !ok || hasContributor(book, sharedAuthorId) || !hasContributor(book, coAuthorId) {
+			t.Errorf("expected the co-written book to be kept with only its other author but got %+v", book.Contributors)
+		}
+		if _, ok := bookRepo.Books[otherBookOfB.Id]; !ok {
+			t.Error("expected the book of the other owner to be kept")
+		}
+		if _, ok := authorRepo.Authors[sharedAuthorId]; ok {
+			t.Error("expected the author to be deleted")
+		}
+	})
+
+	t.Run("reassign while another owner has books", func(t *testing.T) {
+		service, tx, bookRepo, _ := newService(soloBookOfA, bookOfB)
+
+		err := service.DeleteAuthorById(ctxA, pathValues, web.QueryParamsDeleteAuthor{ReassignTo: coAuthorId})
+		expectStatus(t, err, http.StatusConflict)
+
+		if !tx.RolledBack.Load() {
+			t.Error("expected the transaction to be rolled back")
+		}
+		if !hasContributor(bookRepo.Books[bookOfB.Id], sharedAuthorId) {
+			t.Error("expected the book of the other owner to keep its author")
+		}
+	})
+}
+
+func TestMergeAuthorsCollisions(t *testing.T) {
+	const (
+		survivorId  = "c512ae16-5f33-4a3c-a1e1-977bd5a20af3"
+		duplicateId = "e2f1d0c9-b8a7-4c6d-9e5f-4a3b2c1d0e9f"
+
+		bookOfA       = "1a2b3c4d-5e6f-4a8b-9c0d-1e2f3a4b5c6d"
+		mergedBookOfA = "2b3c4d5e-6f7a-4b9c-8d1e-2f3a4b5c6d7e"
+		bookOfB       = "3c4d5e6f-7a8b-4c0d-9e2f-3a4b5c6d7e8f"
+		mergedBookOfB = "4d5e6f7a-8b9c-4d1e-8f3a-4b5c6d7e8f9a"
+		coverOfB      = "9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b.jpg"
+	)
+
+	book := func(id, ownerId, authorId string) domain.Book {
+		return domain.Book{
+			Id:           id,
+			OwnerId:      ownerId,
+			Name:         "The Hobbit",
+			Status:       "plan_to_read",
+			Contributors: []domain.BookContributor{{AuthorId: authorId, Role: domain.ContributorRoleAuthor}},
+		}
+	}
+
+	bookRepo := &MockBookRepository{Books: map[string]domain.Book{}}
+	mergedCoverOfB := book(mergedBookOfB, ownerB, duplicateId)
+	mergedCoverOfB.PhotoKey = coverOfB
+	for _, b := range []domain.Book{
+		book(bookOfA, ownerA, survivorId),
+		book(mergedBookOfA, ownerA, duplicateId),
+		book(bookOfB, ownerB, survivorId),
+		mergedCoverOfB,
+	} {
+		bookRepo.Books[b.Id] = b
+	}
+	authorRepo := &MockAuthorRepository{Authors: map[string]domain.Author{
+		survivorId:  {Id: survivorId, FullName: "J.R.R. Tolkien"},
+		duplicateId: {Id: duplicateId, FullName: "J. R. R. Tolkien"},
+	}}
+	// The finished read stands for the read moved from the merged book
+	readRepo := &MockReadRepository{Reads: []domain.Read{
+		{BookId: bookOfA, StartedDate: "2024-01-01", FinishedDate: "2024-01-20", Outcome: domain.ReadOutcomeFinished},
+	}}
+	tx := &MockTransaction{
+		AuthorRepo:      authorRepo,
+		BookRepo:        bookRepo,
+		SeriesRepo:      &MockSeriesRepository{},
+		AuthorAliasRepo: &MockAuthorAliasRepository{},
+		ReadRepo:        readRepo,
+	}
+	client, store := newPortraitStore(t)
+	cfg := &config.Config{BookBucket: "book-images", AuthorBucket: "author-images"}
+	service := NewAuthorService(&MockUnitOfWork{Tx: tx}, config.ValidatorInit(), client, cfg)
+
+	response, err := service.MergeAuthors(helper.WithUserId(context.Background(), ownerA), web.PathParamsMergeAuthors{Id: survivorId}, web.MergeAuthorsRequest{
+		DuplicateIds: []string{duplicateId},
+	})
+	if err != nil {
+		t.Fatalf("expected the collisions to be merged but got %v", err)
+	}
+
+	expectedCollisions := []web.BookCollisionResponse{{
+		Name:          "The Hobbit",
+		BookId:        bookOfA,
+		MergedBookIds: []string{mergedBookOfA},
+	}}
+	if !reflect.DeepEqual(response.Collisions, expectedCollisions) {
+		t.Errorf("expected only the books of the caller as collisions %v but got %v", expectedCollisions, response.Collisions)
+	}
+
+	t.Run("colliding books of every owner become one", func(t *testing.T) {
+		for _, mergedId := range []string{mergedBookOfA, mergedBookOfB} {
+			if _, ok := bookRepo.Books[mergedId]; ok {
+				t.Errorf("expected book %s to be merged into the first added", mergedId)
+			}
+		}
+
+		for _, b := range bookRepo.Books {
+			if !hasContributor(b, survivorId) || hasContributor(b, duplicateId) {
+				t.Errorf("expected book %s to move to the surviving author but got %+v", b.Id, b.Contributors)
+			}
+		}
+	})
+
+	t.Run("the kept book follows its reads", func(t *testing.T) {
+		if kept := bookRepo.Books[bookOfA]; kept.Status != "completed" || kept.CompletedDate != "2024-01-20" {
+			t.Errorf("expected completed on 2024-01-20 but got %s on '%s'", kept.Status, kept.CompletedDate)
+		}
+	})
+
+	t.Run("the cover of a merged book is removed", func(t *testing.T) {
+		expected := []string{"/" + cfg.BookBucket + "/" + coverOfB}
+		if removed := store.Removed(); !slices.Equal(removed, expected) {
+			t.Errorf("expected %v to be removed but got %v", expected, removed)
+		}
+	})
+
+	if _, ok := authorRepo.Authors[duplicateId]; ok {
+		t.Error("expected the duplicate to be deleted")
+	}
+}
+
+// portraitStore stands in for MinIO and records the objects removed from it
+type portraitStore struct {
+	mu      sync.Mutex
+	removed []string
+}
+
+func (store *portraitStore) Removed() []string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return slices.Clone(store.removed)
+}
+
+func newPortraitStore(t *testing.T) (*minio.Client, *portraitStore) {
+	store := &portraitStore{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			store.mu.Lock()
+			store.removed = append(store.removed, r.URL.Path)
+			store.mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	// The region is set so the client never asks for the bucket location
+	client, err := minio.New(strings.TrimPrefix(server.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("access-key", "secret-key", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("failed to create MinIO client: %v", err)
+	}
+
+	return client, store
+}
+
+func TestAuthorPortraitRemoval(t *testing.T) {
+	const (
+		authorId      = "c512ae16-5f33-4a3c-a1e1-977bd5a20af3"
+		duplicateId   = "e2f1d0c9-b8a7-4c6d-9e5f-4a3b2c1d0e9f"
+		thirdAuthorId = "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"
+
+		portraitKey      = "authors/9b2c7e41-5d0a-4f3e-8c61-2a7d4e9f0b13.jpg"
+		otherPortraitKey = "authors/5f8a1c2e-3b4d-4e6f-9a7b-8c9d0e1f2a3b.jpg"
+		bookCoverKey     = "7d1e2f3a-4b5c-4d6e-8f7a-9b0c1d2e3f4a.jpg"
+	)
+
+	cfg := &config.Config{BookBucket: "book-images", AuthorBucket: "book-images"}
+	ctxA := helper.WithUserId(context.Background(), ownerA)
+
+	newService := func(t *testing.T, authors ...domain.Author) (AuthorService, *portraitStore) {
+		authorRepo := &MockAuthorRepository{Authors: map[string]domain.Author{}}
+		for _, author := range authors {
+			authorRepo.Authors[author.Id] = author
+		}
+		tx := &MockTransaction{
+			AuthorRepo:      authorRepo,
+			BookRepo:        &MockBookRepository{Books: map[string]domain.Book{}},
+			SeriesRepo:      &MockSeriesRepository{},
+			AuthorAliasRepo: &MockAuthorAliasRepository{},
+		}
+		client, store := newPortraitStore(t)
+		return NewAuthorService(&MockUnitOfWork{Tx: tx}, config.ValidatorInit(), client, cfg), store
+	}
+
+	expectRemoved := func(t *testing.T, store *portraitStore, photoKeys ...string) {
+		t.Helper()
+
+		expected := []string{}
+		for _, photoKey := range photoKeys {
+			expected = append(expected, "/"+cfg.AuthorBucket+"/"+photoKey)
+		}
+		removed := store.Removed()
+		slices.Sort(removed)
+		if !slices.Equal(removed, expected) {
+			t.Errorf("expected %v to be removed but got %v", expected, removed)
+		}
+	}
+
+	t.Run("delete removes the portrait", func(t *testing.T) {
+		service, store := newService(t, domain.Author{Id: authorId, FullName: "Leila S. Chudori", PhotoKey: portraitKey})
+
+		err := service.DeleteAuthorById(ctxA, web.PathParamsDeleteAuthor{Id: authorId}, web.QueryParamsDeleteAuthor{})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store, portraitKey)
+	})
+
+	t.Run("delete leaves a key outside the portrait prefix", func(t *testing.T) {
+		service, store := newService(t, domain.Author{Id: authorId, FullName: "Leila S. Chudori", PhotoKey: bookCoverKey})
+
+		err := service.DeleteAuthorById(ctxA, web.PathParamsDeleteAuthor{Id: authorId}, web.QueryParamsDeleteAuthor{})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store)
+	})
+
+	t.Run("update removes the replaced portrait", func(t *testing.T) {
+		service, store := newService(t, domain.Author{Id: authorId, FullName: "Leila S. Chudori", Nationality: "Indonesia", PhotoKey: portraitKey})
+
+		response, err := service.UpdateAuthorById(ctxA, web.PathParamsUpdateAuthor{Id: authorId}, web.UpdateAuthorRequest{
+			FullName:    "Leila S. Chudori",
+			Nationality: "Indonesia",
+			PhotoKey:    otherPortraitKey,
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+		if response.PhotoKey != otherPortraitKey {
+			t.Errorf("expected photo key %s but got %s", otherPortraitKey, response.PhotoKey)
+		}
+
+		expectRemoved(t, store, portraitKey)
+	})
+
+	t.Run("update keeps the portrait it still uses", func(t *testing.T) {
+		service, store := newService(t, domain.Author{Id: authorId, FullName: "Leila S. Chudori", Nationality: "Indonesia", PhotoKey: portraitKey})
+
+		_, err := service.UpdateAuthorById(ctxA, web.PathParamsUpdateAuthor{Id: authorId}, web.UpdateAuthorRequest{
+			FullName:    "Leila S. Chudori",
+			Nationality: "Indonesia",
+			PhotoKey:    portraitKey,
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store)
+	})
+
+	t.Run("merge removes the portraits of the duplicates", func(t *testing.T) {
+		service, store := newService(t,
+			domain.Author{Id: authorId, FullName: "J.R.R. Tolkien", PhotoKey: portraitKey},
+			domain.Author{Id: duplicateId, FullName: "J. R. R. Tolkien", PhotoKey: otherPortraitKey},
+		)
+
+		_, err := service.MergeAuthors(ctxA, web.PathParamsMergeAuthors{Id: authorId}, web.MergeAuthorsRequest{
+			DuplicateIds: []string{duplicateId},
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store, otherPortraitKey)
+	})
+
+	t.Run("update rejects the portrait of another author", func(t *testing.T) {
+		service, store := newService(t,
+			domain.Author{Id: authorId, FullName: "Leila S. Chudori", Nationality: "Indonesia", PhotoKey: portraitKey},
+			domain.Author{Id: duplicateId, FullName: "Laksmi Pamuntjak", Nationality: "Indonesia", PhotoKey: otherPortraitKey},
+		)
+
+		_, err := service.UpdateAuthorById(ctxA, web.PathParamsUpdateAuthor{Id: duplicateId}, web.UpdateAuthorRequest{
+			FullName:    "Laksmi Pamuntjak",
+			Nationality: "Indonesia",
+			PhotoKey:    portraitKey,
+		})
+		expectStatus(t, err, http.StatusBadRequest)
+
+		expectRemoved(t, store)
+	})
+
+	// Authors stored before portrait keys were checked may still share one
+	sharingAuthors := []domain.Author{
+		{Id: authorId, FullName: "J.R.R. Tolkien", Nationality: "United Kingdom", PhotoKey: portraitKey},
+		{Id: duplicateId, FullName: "J. R. R. Tolkien", Nationality: "United Kingdom", PhotoKey: portraitKey},
+		{Id: thirdAuthorId, FullName: "Christopher Tolkien", Nationality: "United Kingdom", PhotoKey: otherPortraitKey},
+	}
+
+	t.Run("delete keeps a portrait another author shares", func(t *testing.T) {
+		service, store := newService(t, sharingAuthors...)
+
+		err := service.DeleteAuthorById(ctxA, web.PathParamsDeleteAuthor{Id: duplicateId}, web.QueryParamsDeleteAuthor{})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store)
+	})
+
+	t.Run("update keeps a portrait another author shares", func(t *testing.T) {
+		service, store := newService(t, sharingAuthors...)
+
+		_, err := service.UpdateAuthorById(ctxA, web.PathParamsUpdateAuthor{Id: duplicateId}, web.UpdateAuthorRequest{
+			FullName:    "J. R. R. Tolkien",
+			Nationality: "United Kingdom",
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store)
+	})
+
+	t.Run("merge keeps a portrait another author shares", func(t *testing.T) {
+		service, store := newService(t, sharingAuthors...)
+
+		_, err := service.MergeAuthors(ctxA, web.PathParamsMergeAuthors{Id: thirdAuthorId}, web.MergeAuthorsRequest{
+			DuplicateIds: []string{duplicateId},
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		expectRemoved(t, store)
+	})
+}
@@ -17,22 +17,24 @@ import (
 	"github.com/minio/minio-go/v7"
 )
 
-func NewBookService(uow UnitOfWork, authorService AuthorService, validate *validator.Validate, minioClient *minio.Client, cfg *config.Config) BookService {
+func NewBookService(uow UnitOfWork, authorService AuthorService, validate *validator.Validate, minioClient *minio.Client, cfg *config.Config, metadataProvider MetadataProvider) BookService {
 	return &BookServiceImpl{
-		UoW:           uow,
-		AuthorService: authorService,
-		Validate:      validate,
-		MiniIOClient:  minioClient,
-		Config:        cfg,
+		UoW:              uow,
+		AuthorService:    authorService,
+		Validate:         validate,
+		MiniIOClient:     minioClient,
+		Config:           cfg,
+		MetadataProvider: metadataProvider,
 	}
 }
 
 type BookServiceImpl struct {
-	UoW           UnitOfWork
-	AuthorService AuthorService
-	Validate      *validator.Validate
-	MiniIOClient  *minio.Client
-	Config        *config.Config
+	UoW              UnitOfWork
+	AuthorService    AuthorService
+	Validate         *validator.Validate
+	MiniIOClient     *minio.Client
+	Config           *config.Config
+	MetadataProvider MetadataProvider
 }
 
 func (service *BookServiceImpl) CreateNewBook(ctx context.Context, request web.CreateBookRequest) (web.CreateBookResponse, error) {
@@ -42,6 +44,12 @@ func (service *BookServiceImpl) CreateNewBook(ctx context.Context, request web.C
 		return web.CreateBookResponse{}, err
 	}
 
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.CreateBookResponse{}, err
+	}
+
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
@@ -65,30 +73,57 @@ func (service *BookServiceImpl) CreateNewBook(ctx context.Context, request web.C
 	// It creates a new instance of BookRepository
 	bookRepo := tx.GetBookRepository()
 
-	// Check if author_id exists
-	_, err = service.AuthorService.GetAuthorById(ctx, web.PathParamsGetAuthor{Id: request.AuthorId})
+	// Check if every contributor exists
+	contributors := toBookContributors(request.AuthorId, request.AuthorAliasId, request.Contributors)
+	contributors, notFound, err := service.findContributors(ctx, tx, contributors)
 	if err != nil {
-		errAggregate = append(errAggregate, appError.ErrAggregate{
-			Field:   "author_id",
-			Message: fmt.Sprintf("author with id '%v' is not found", request.AuthorId),
-		})
+		return web.CreateBookResponse{}, err
+	}
+	if len(notFound) != 0 {
+		return web.CreateBookResponse{}, appError.NewAppError(
+			http.StatusNotFound,
+			notFound,
+			nil,
+		)
+	}
 
+	// Check if series exists
+	seriesNotFound, err := findSeries(ctx, tx, userId, request.SeriesId)
+	if err != nil {
+		return web.CreateBookResponse{}, err
+	}
+	if len(seriesNotFound) != 0 {
 		return web.CreateBookResponse{}, appError.NewAppError(
 			http.StatusNotFound,
-			errAggregate,
+			seriesNotFound,
 			nil,
 		)
 	}
 
-	// Check if there is a book with the same name and the same author_id
-	err = bookRepo.CheckByNameAndAuthorId(ctx, request.Name, request.AuthorId)
+	// Check if an author is listed twice with the same role
+	errAggregate = append(errAggregate, duplicateContributors(contributors)...)
+
+	// Check if there is a book with the same name and the same primary author
+	authorId := contributors[0].AuthorId
+	err = bookRepo.CheckByNameAndAuthorId(ctx, userId, request.Name, authorId)
 	if err != nil {
 		errAggregate = append(errAggregate, appError.ErrAggregate{
 			Field:   "name",
-			Message: fmt.Sprintf("%v with author_id '%v' is already exists", request.Name, request.AuthorId),
+			Message: fmt.Sprintf("%v with author_id '%v' is already exists", request.Name, authorId),
 		})
 	}
 
+	// Check if current_page is within total_page
+	if request.CurrentPage > request.TotalPage {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "current_page",
+			Message: fmt.Sprintf("current_page must not be greater than total_page (%d)", request.TotalPage),
+		})
+	}
+
+	// Check if completed_date agrees with status
+	errAggregate = append(errAggregate, completedDateErrors(request.Status, request.CompletedDate, time.Now())...)
+
 	if len(errAggregate) != 0 {
 		return web.CreateBookResponse{}, appError.NewAppError(
 			http.StatusBadRequest,
@@ -98,12 +133,21 @@ func (service *BookServiceImpl) CreateNewBook(ctx context.Context, request web.C
 	}
 
 	book := domain.Book{
-		Name:          request.Name,
-		TotalPage:     request.TotalPage,
-		AuthorId:      request.AuthorId,
-		PhotoKey:      request.PhotoKey,
-		Status:        request.Status,
-		CompletedDate: request.CompletedDate,
+		OwnerId:         userId,
+		Name:            request.Name,
+		TotalPage:       request.TotalPage,
+		CurrentPage:     request.CurrentPage,
+		AuthorId:        authorId,
+		PhotoKey:        request.PhotoKey,
+		Status:          request.Status,
+		CompletedDate:   bookCompletedDate(request.Status, request.CompletedDate),
+		Isbn:            helper.NormalizeISBN(request.Isbn),
+		Publisher:       request.Publisher,
+		PublicationYear: request.PublicationYear,
+		Language:        request.Language,
+		SeriesId:        request.SeriesId,
+		SeriesPosition:  seriesPosition(request.SeriesPosition),
+		Contributors:    contributors,
 	}
 
 	// Call repository
@@ -112,20 +156,49 @@ func (service *BookServiceImpl) CreateNewBook(ctx context.Context, request web.C
 		return web.CreateBookResponse{}, err
 	}
 
+	// A book that is being read or has been read gets its first read
+	err = recordRead(ctx, tx.GetReadRepository(), book.Id, "", book.Status, statusDate(book))
+	if err != nil {
+		return web.CreateBookResponse{}, err
+	}
+
 	return helper.ToCreateBookResponse(book), nil
 }
 
-func (service *BookServiceImpl) GetAllBooks(ctx context.Context, queries web.QueryParamsGetBooks) ([]web.GetBookResponse, error) {
+func (service *BookServiceImpl) GetAllBooks(ctx context.Context, queries web.QueryParamsGetBooks) ([]web.GetBookResponse, web.PaginationMeta, error) {
+	// Filters are compared with the names as stored, in NFC
+	queries.AuthorName = helper.NormalizeName(queries.AuthorName)
+
 	// Validate queries
 	err := service.Validate.Struct(queries)
 	if err != nil {
-		return []web.GetBookResponse{}, err
+		return []web.GetBookResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Dates are already validated as YYYY-MM-DD so they compare as strings
+	if queries.CompletedFrom != "" && queries.CompletedTo != "" && queries.CompletedFrom > queries.CompletedTo {
+		return []web.GetBookResponse{}, web.PaginationMeta{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "completed_from",
+					Message: "completed_from must not be after completed_to",
+				},
+			},
+			nil,
+		)
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.GetBookResponse{}, web.PaginationMeta{}, err
 	}
 
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
-		return []web.GetBookResponse{}, err
+		return []web.GetBookResponse{}, web.PaginationMeta{}, err
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -142,39 +215,68 @@ func (service *BookServiceImpl) GetAllBooks(ctx context.Context, queries web.Que
 	// It creates a new instance of BookRepository
 	bookRepo := tx.GetBookRepository()
 
+	// Newest books come first unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "-created_at")
+
 	// Call repository
-	books, err := bookRepo.FindAll(ctx, queries.Name, queries.Status, queries.AuthorName)
+	filter := domain.BookFilter{
+		Name:          queries.Name,
+		Status:        queries.Status,
+		AuthorName:    queries.AuthorName,
+		CompletedFrom: queries.CompletedFrom,
+		CompletedTo:   queries.CompletedTo,
+		RatingMin:     queries.RatingMin,
+		Tags:          queries.Tags,
+		TagsMatch:     queries.TagsMatch,
+	}
+
+	// A book must carry every given tag unless tags_match=any
+	if filter.TagsMatch == "" {
+		filter.TagsMatch = domain.TagsMatchAll
+	}
+
+	books, total, err := bookRepo.FindAll(ctx, userId, filter, page)
 	if err != nil {
-		return []web.GetBookResponse{}, err
+		return []web.GetBookResponse{}, web.PaginationMeta{}, err
 	}
 
 	// No records return []
 	if len(books) == 0 {
-		return []web.GetBookResponse{}, nil
+		return []web.GetBookResponse{}, helper.ToPaginationMeta(page, total), nil
 	}
 
 	booksWithURL := []domain.BookWithURL{}
 
 	for _, book := range books {
-		presignedURL, err := service.MiniIOClient.PresignedGetObject(ctx, service.Config.BookBucket, book.PhotoKey, 24*time.Hour, nil)
+		photoURL, err := service.photoURL(ctx, book.PhotoKey)
 		if err != nil {
-			return []web.GetBookResponse{}, err
+			return []web.GetBookResponse{}, web.PaginationMeta{}, err
 		}
 
 		booksWithURL = append(booksWithURL, domain.BookWithURL{
-			Id:            book.Id,
-			Name:          book.Name,
-			TotalPage:     book.TotalPage,
-			AuthorId:      book.AuthorId,
-			PhotoURL:      presignedURL.String(),
-			Status:        book.Status,
-			CompletedDate: book.CompletedDate,
-			CreatedAt:     book.CreatedAt,
-			UpdatedAt:     book.UpdatedAt,
+			Id:              book.Id,
+			Name:            book.Name,
+			TotalPage:       book.TotalPage,
+			CurrentPage:     book.CurrentPage,
+			AuthorId:        book.AuthorId,
+			PhotoURL:        photoURL,
+			Status:          book.Status,
+			CompletedDate:   book.CompletedDate,
+			Isbn:            book.Isbn,
+			Publisher:       book.Publisher,
+			PublicationYear: book.PublicationYear,
+			Language:        book.Language,
+			SeriesId:        book.SeriesId,
+			SeriesPosition:  book.SeriesPosition,
+			Rating:          book.Rating,
+			Contributors:    book.Contributors,
+			Tags:            book.Tags,
+			CreatedAt:       book.CreatedAt,
+			UpdatedAt:       book.UpdatedAt,
 		})
 	}
 
-	return helper.ToGetBooksResponse(booksWithURL), nil
+	return helper.ToGetBooksResponse(booksWithURL), helper.ToPaginationMeta(page, total), nil
 }
 
 func (service *BookServiceImpl) GetBookById(ctx context.Context, pathValues web.PathParamsGetBook) (web.GetBookResponse, error) {
@@ -184,6 +286,12 @@ func (service *BookServiceImpl) GetBookById(ctx context.Context, pathValues web.
 		return web.GetBookResponse{}, err
 	}
 
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GetBookResponse{}, err
+	}
+
 	// Open transcation
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
@@ -208,7 +316,7 @@ func (service *BookServiceImpl) GetBookById(ctx context.Context, pathValues web.
 	bookRepo := tx.GetBookRepository()
 
 	// Call repository
-	book, err := bookRepo.FindById(ctx, pathValues.Id)
+	book, err := bookRepo.FindById(ctx, userId, pathValues.Id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			errAggregate = append(errAggregate, appError.ErrAggregate{
@@ -226,21 +334,31 @@ func (service *BookServiceImpl) GetBookById(ctx context.Context, pathValues web.
 	}
 
 	// Create presigned URL for GET object
-	presignedURL, err := service.MiniIOClient.PresignedGetObject(ctx, service.Config.BookBucket, book.PhotoKey, 24*time.Hour, nil)
+	photoURL, err := service.photoURL(ctx, book.PhotoKey)
 	if err != nil {
 		return web.GetBookResponse{}, err
 	}
 
 	bookWithURL := domain.BookWithURL{
-		Id:            book.Id,
-		Name:          book.Name,
-		TotalPage:     book.TotalPage,
-		AuthorId:      book.AuthorId,
-		PhotoURL:      presignedURL.String(),
-		Status:        book.Status,
-		CompletedDate: book.CompletedDate,
-		CreatedAt:     book.CreatedAt,
-		UpdatedAt:     book.UpdatedAt,
+		Id:              book.Id,
+		Name:            book.Name,
+		TotalPage:       book.TotalPage,
+		CurrentPage:     book.CurrentPage,
+		AuthorId:        book.AuthorId,
+		PhotoURL:        photoURL,
+		Status:          book.Status,
+		CompletedDate:   book.CompletedDate,
+		Isbn:            book.Isbn,
+		Publisher:       book.Publisher,
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		SeriesId:        book.SeriesId,
+		SeriesPosition:  book.SeriesPosition,
+		Rating:          book.Rating,
+		Contributors:    book.Contributors,
+		Tags:            book.Tags,
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
 	}
 
 	return helper.ToGetBookResponse(bookWithURL), nil
@@ -259,6 +377,12 @@ func (service *BookServiceImpl) UpdateBookById(ctx context.Context, pathValues w
 		return web.UpdateBookResponse{}, err
 	}
 
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.UpdateBookResponse{}, err
+	}
+
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
@@ -283,7 +407,7 @@ func (service *BookServiceImpl) UpdateBookById(ctx context.Context, pathValues w
 	bookRepo := tx.GetBookRepository()
 
 	// Check if id is exists
-	_, err = bookRepo.FindById(ctx, pathValues.Id)
+	previous, err := bookRepo.FindById(ctx, userId, pathValues.Id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			errAggregate = append(errAggregate, appError.ErrAggregate{
@@ -302,28 +426,45 @@ func (service *BookServiceImpl) UpdateBookById(ctx context.Context, pathValues w
 		}
 	}
 
-	// Check if author_id exists
-	_, err = service.AuthorService.GetAuthorById(ctx, web.PathParamsGetAuthor{Id: request.AuthorId})
+	// Check if every contributor exists
+	contributors := toBookContributors(request.AuthorId, request.AuthorAliasId, request.Contributors)
+	contributors, notFound, err := service.findContributors(ctx, tx, contributors)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			errAggregate = append(errAggregate, appError.ErrAggregate{
-				Field:   "author_id",
-				Message: fmt.Sprintf("author with id '%v' is not found", request.AuthorId),
-			})
-		} else {
-			return web.UpdateBookResponse{}, err
-		}
+		return web.UpdateBookResponse{}, err
 	}
+	errAggregate = append(errAggregate, notFound...)
 
-	// Check if there is a book with the same name and the same author_id
-	err = bookRepo.CheckByNameAndAuthorId(ctx, request.Name, request.AuthorId)
+	// Check if series exists
+	seriesNotFound, err := findSeries(ctx, tx, userId, request.SeriesId)
+	if err != nil {
+		return web.UpdateBookResponse{}, err
+	}
+	errAggregate = append(errAggregate, seriesNotFound...)
+
+	// Check if an author is listed twice with the same role
+	errAggregate = append(errAggregate, duplicateContributors(contributors)...)
+
+	// Check if there is a book with the same name and the same primary author
+	authorId := contributors[0].AuthorId
+	err = bookRepo.CheckByNameAndAuthorId(ctx, userId, request.Name, authorId)
 	if err != nil {
 		errAggregate = append(errAggregate, appError.ErrAggregate{
 			Field:   "name",
-			Message: fmt.Sprintf("%v with author_id '%v' is already exists", request.Name, request.AuthorId),
+			Message: fmt.Sprintf("%v with author_id '%v' is already exists", request.Name, authorId),
+		})
+	}
+
+	// Check if current_page is within total_page
+	if request.CurrentPage > request.TotalPage {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "current_page",
+			Message: fmt.Sprintf("current_page must not be greater than total_page (%d)", request.TotalPage),
 		})
 	}
 
+	// Check if completed_date agrees with status
+	errAggregate = append(errAggregate, completedDateErrors(request.Status, request.CompletedDate, time.Now())...)
+
 	if len(errAggregate) != 0 {
 		return web.UpdateBookResponse{}, appError.NewAppError(
 			http.StatusBadRequest,
@@ -333,21 +474,38 @@ func (service *BookServiceImpl) UpdateBookById(ctx context.Context, pathValues w
 	}
 
 	book := domain.Book{
-		Id:            pathValues.Id,
-		Name:          request.Name,
-		TotalPage:     request.TotalPage,
-		AuthorId:      request.AuthorId,
-		PhotoKey:      request.PhotoKey,
-		Status:        request.Status,
-		CompletedDate: request.CompletedDate,
+		Id:              pathValues.Id,
+		OwnerId:         userId,
+		Name:            request.Name,
+		TotalPage:       request.TotalPage,
+		CurrentPage:     request.CurrentPage,
+		AuthorId:        authorId,
+		PhotoKey:        request.PhotoKey,
+		Status:          request.Status,
+		CompletedDate:   bookCompletedDate(request.Status, request.CompletedDate),
+		Isbn:            helper.NormalizeISBN(request.Isbn),
+		Publisher:       request.Publisher,
+		PublicationYear: request.PublicationYear,
+		Language:        request.Language,
+		SeriesId:        request.SeriesId,
+		SeriesPosition:  seriesPosition(request.SeriesPosition),
+		Contributors:    contributors,
 	}
 
 	// Call repository
-	book, err = bookRepo.Update(ctx, pathValues.Id, book)
+	book, err = bookRepo.Update(ctx, userId, pathValues.Id, book)
 	if err != nil {
 		return web.UpdateBookResponse{}, err
 	}
 
+	// A new status or completed_date is recorded on the reads of the book
+	if previous.Status != book.Status || previous.CompletedDate != book.CompletedDate {
+		err = recordRead(ctx, tx.GetReadRepository(), book.Id, previous.Status, book.Status, statusDate(book))
+		if err != nil {
+			return web.UpdateBookResponse{}, err
+		}
+	}
+
 	return helper.ToUpdateBookResponse(book), nil
 }
 
@@ -358,6 +516,12 @@ func (service *BookServiceImpl) DeleteBookById(ctx context.Context, pathValues w
 		return err
 	}
 
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Open transaction
 	tx, err := service.UoW.Begin(ctx)
 	if err != nil {
@@ -382,7 +546,7 @@ func (service *BookServiceImpl) DeleteBookById(ctx context.Context, pathValues w
 	bookRepo := tx.GetBookRepository()
 
 	// Check if id is exists
-	_, err = bookRepo.FindById(ctx, pathValues.Id)
+	_, err = bookRepo.FindById(ctx, userId, pathValues.Id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			errAggregate = append(errAggregate, appError.ErrAggregate{
@@ -401,11 +565,294 @@ func (service *BookServiceImpl) DeleteBookById(ctx context.Context, pathValues w
 		}
 	}
 
+	// Remove the notes and highlights of the book in the same transaction
+	err = tx.GetNoteRepository().DeleteAllByBookId(ctx, pathValues.Id)
+	if err != nil {
+		return err
+	}
+
+	err = tx.GetHighlightRepository().DeleteAllByBookId(ctx, pathValues.Id)
+	if err != nil {
+		return err
+	}
+
 	// Call repository
-	err = bookRepo.Delete(ctx, pathValues.Id)
+	err = bookRepo.Delete(ctx, userId, pathValues.Id)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (service *BookServiceImpl) LookupBookByIsbn(ctx context.Context, request web.LookupBookRequest) (web.LookupBookResponse, error) {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.LookupBookResponse{}, err
+	}
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// Ask the metadata provider before opening a transaction, it may be a slow network call
+	metadata, err := service.MetadataProvider.LookupByISBN(ctx, request.Isbn)
+	if err != nil {
+		if errors.Is(err, ErrMetadataNotFound) {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "isbn",
+				Message: fmt.Sprintf("no book is found for isbn '%s'", request.Isbn),
+			})
+
+			return web.LookupBookResponse{}, appError.NewAppError(
+				http.StatusNotFound,
+				errAggregate,
+				err,
+			)
+		}
+
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "isbn",
+			Message: "metadata provider is unavailable, try again later",
+		})
+
+		return web.LookupBookResponse{}, appError.NewAppError(
+			http.StatusBadGateway,
+			errAggregate,
+			err,
+		)
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.LookupBookResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of AuthorRepository
+	authorRepo := tx.GetAuthorRepository()
+
+	// Match the provider authors with the catalog by full_name
+	authors := []web.LookupAuthorResponse{}
+	for _, fullName := range metadata.Authors {
+		var author domain.Author
+		fullName = helper.NormalizeName(fullName)
+		author, err = authorRepo.FindByFullName(ctx, fullName)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return web.LookupBookResponse{}, err
+			}
+			err = nil
+		}
+
+		authors = append(authors, web.LookupAuthorResponse{
+			FullName: fullName,
+			AuthorId: author.Id,
+		})
+	}
+
+	return helper.ToLookupBookResponse(metadata, authors), nil
+}
+
+// toBookContributors turns the contributors of a request into book contributors,
+// a request with only author_id has that author as its single contributor
+func toBookContributors(authorId, aliasId string, requests []web.BookContributorRequest) []domain.BookContributor {
+	if len(requests) == 0 {
+		return []domain.BookContributor{
+			{
+				AuthorId: authorId,
+				Role:     domain.ContributorRoleAuthor,
+				AliasId:  aliasId,
+			},
+		}
+	}
+
+	contributors := make([]domain.BookContributor, 0, len(requests))
+	for i, request := range requests {
+		contributors = append(contributors, domain.BookContributor{
+			AuthorId: request.AuthorId,
+			Role:     request.Role,
+			Position: i,
+			AliasId:  request.AliasId,
+		})
+	}
+
+	return contributors
+}
+
+// findContributors fills the full_name of every contributor from the catalog, contributors that are not
+// in the catalog are returned as errors on author_id and aliases of another author as errors on alias_id
+func (service *BookServiceImpl) findContributors(ctx context.Context, tx Transaction, contributors []domain.BookContributor) ([]domain.BookContributor, []appError.ErrAggregate, error) {
+	authorRepo := tx.GetAuthorRepository()
+	aliasRepo := tx.GetAuthorAliasRepository()
+
+	notFound := []appError.ErrAggregate{}
+	for i, contributor := range contributors {
+		author, err := authorRepo.FindById(ctx, contributor.AuthorId)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, err
+			}
+
+			notFound = append(notFound, appError.ErrAggregate{
+				Field:   "author_id",
+				Message: fmt.Sprintf("author with id '%v' is not found", contributor.AuthorId),
+			})
+			continue
+		}
+
+		contributors[i].FullName = author.FullName
+
+		if contributor.AliasId == "" {
+			continue
+		}
+
+		alias, err := aliasRepo.FindById(ctx, contributor.AuthorId, contributor.AliasId)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, err
+			}
+
+			notFound = append(notFound, appError.ErrAggregate{
+				Field:   "alias_id",
+				Message: fmt.Sprintf("alias with id '%v' of author '%v' is not found", contributor.AliasId, contributor.AuthorId),
+			})
+			continue
+		}
+
+		contributors[i].PublishedAs = alias.Name
+	}
+
+	return contributors, notFound, nil
+}
+
+// findOwnedBook returns a book of the user, or a 404 AppError when the user has no such book
+func findOwnedBook(ctx context.Context, tx Transaction, userId, bookId string) (domain.Book, error) {
+	book, err := tx.GetBookRepository().FindById(ctx, userId, bookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Book{}, appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("book with id '%s' is not found", bookId),
+					},
+				},
+				fmt.Errorf("book with id '%s' is not found", bookId),
+			)
+		}
+		return domain.Book{}, err
+	}
+
+	return book, nil
+}
+
+// findSeries checks that a book is put into a series of its owner,
+// a book without a series is always valid
+func findSeries(ctx context.Context, tx Transaction, ownerId, seriesId string) ([]appError.ErrAggregate, error) {
+	if seriesId == "" {
+		return nil, nil
+	}
+
+	_, err := tx.GetSeriesRepository().FindById(ctx, ownerId, seriesId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		return []appError.ErrAggregate{{
+			Field:   "series_id",
+			Message: fmt.Sprintf("series with id '%v' is not found", seriesId),
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+// completedDateErrors checks completed_date against status, a completed book needs a date that is not in the future
+func completedDateErrors(status, completedDate string, now time.Time) []appError.ErrAggregate {
+	if status != "completed" {
+		return nil
+	}
+
+	if completedDate == "" {
+		return []appError.ErrAggregate{{
+			Field:   "completed_date",
+			Message: "completed_date is required when status is 'completed'",
+		}}
+	}
+
+	// Dates are already validated as YYYY-MM-DD so they compare as strings
+	if completedDate > now.Format("2006-01-02") {
+		return []appError.ErrAggregate{{
+			Field:   "completed_date",
+			Message: "completed_date must not be in the future",
+		}}
+	}
+
+	return nil
+}
+
+// bookCompletedDate keeps completed_date only for completed books, any other status clears it
+func bookCompletedDate(status, completedDate string) string {
+	if status != "completed" {
+		return ""
+	}
+
+	return completedDate
+}
+
+// seriesPosition reads the optional series_position of a request,
+// validation guarantees it is given whenever series_id is
+func seriesPosition(position *float64) float64 {
+	if position == nil {
+		return 0
+	}
+	return *position
+}
+
+// duplicateContributors reports authors listed twice with the same role,
+// the same author may still be listed with different roles such as author and illustrator
+func duplicateContributors(contributors []domain.BookContributor) []appError.ErrAggregate {
+	errAggregate := []appError.ErrAggregate{}
+
+	seen := map[domain.BookContributor]bool{}
+	for _, contributor := range contributors {
+		key := domain.BookContributor{AuthorId: contributor.AuthorId, Role: contributor.Role}
+		if seen[key] {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "contributors",
+				Message: fmt.Sprintf("author with id '%v' is listed more than once as %v", contributor.AuthorId, contributor.Role),
+			})
+		}
+		seen[key] = true
+	}
+
+	return errAggregate
+}
+
+// photoURL presigns the cover of a book, imported books have no cover so they get an empty URL
+func (service *BookServiceImpl) photoURL(ctx context.Context, photoKey string) (string, error) {
+	if photoKey == "" {
+		return "", nil
+	}
+
+	presignedURL, err := service.MiniIOClient.PresignedGetObject(ctx, service.Config.BookBucket, photoKey, 24*time.Hour, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return presignedURL.String(), nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+const (
+	ownerA = "0b7e9f4a-1c2d-4e3f-8a9b-0c1d2e3f4a5b"
+	ownerB = "5d6e7f80-9a1b-4c2d-8e3f-4a5b6c7d8e9f"
+)
+
+// MockBookRepository keeps books in memory and scopes them to their owner like the SQL does
+type MockBookRepository struct {
+	repository.BookRepository
+
+	Books map[string]domain.Book
+}
+
+func (m *MockBookRepository) FindById(ctx context.Context, ownerId, bookId string) (domain.Book, error) {
+	book, ok := m.Books[bookId]
+	if !ok || book.OwnerId != ownerId {
+		return domain.Book{}, pgx.ErrNoRows
+	}
+
+	return book, nil
+}
+
+func (m *MockBookRepository) Delete(ctx context.Context, ownerId, bookId string) error {
+	if book, ok := m.Books[bookId]; ok && book.OwnerId == ownerId {
+		delete(m.Books, bookId)
+	}
+
+	return nil
+}
+
+func expectStatus(t *testing.T, err error, statusCode int) {
+	t.Helper()
+
+	var appErr *appError.AppError
+	if !errors.As(err, &appErr) || appErr.StatusCode != statusCode {
+		t.Errorf("expected status code of %d but got %v", statusCode, err)
+	}
+}
+
+func TestBookOwnerScope(t *testing.T) {
+	bookOfB := domain.Book{
+		Id:      "43723811-c8e3-4cba-85cc-142954064ae4",
+		OwnerId: ownerB,
+		Name:    "Laut Bercerita",
+	}
+
+	newService := func() (BookService, *MockBookRepository) {
+		bookRepo := &MockBookRepository{Books: map[string]domain.Book{bookOfB.Id: bookOfB}}
+		uow := &MockUnitOfWork{Tx: &MockTransaction{BookRepo: bookRepo}}
+		return NewBookService(uow, nil, config.ValidatorInit(), nil, &config.Config{}, nil), bookRepo
+	}
+
+	ctxA := helper.WithUserId(context.Background(), ownerA)
+
+	t.Run("get a book of another owner", func(t *testing.T) {
+		service, _ := newService()
+
+		_, err := service.GetBookById(ctxA, web.PathParamsGetBook{Id: bookOfB.Id})
+		expectStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("update a book of another owner", func(t *testing.T) {
+		service, bookRepo := newService()
+
+		_, err := service.UpdateBookById(ctxA, web.PathParamsUpdateBook{Id: bookOfB.Id}, web.UpdateBookRequest{
+			Name:      "Taken Over",
+			TotalPage: 379,
+			AuthorId:  "c512ae16-5f33-4a3c-a1e1-977bd5a20af3",
+			PhotoKey:  "cover.jpg",
+			Status:    "reading",
+		})
+		expectStatus(t, err, http.StatusNotFound)
+
+		if bookRepo.Books[bookOfB.Id].Name != bookOfB.Name {
+			t.Errorf("expected the book of the other owner to keep its name but got %s", bookRepo.Books[bookOfB.Id].Name)
+		}
+	})
+
+	t.Run("delete a book of another owner", func(t *testing.T) {
+		service, bookRepo := newService()
+
+		err := service.DeleteBookById(ctxA, web.PathParamsDeleteBook{Id: bookOfB.Id})
+		expectStatus(t, err, http.StatusNotFound)
+
+		if _, ok := bookRepo.Books[bookOfB.Id]; !ok {
+			t.Error("expected the book of the other owner to be kept")
+		}
+	})
+
+	t.Run("get own book", func(t *testing.T) {
+		service, _ := newService()
+
+		book, err := service.GetBookById(helper.WithUserId(context.Background(), ownerB), web.PathParamsGetBook{Id: bookOfB.Id})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if book.Id != bookOfB.Id {
+			t.Errorf("expected book %s but got %s", bookOfB.Id, book.Id)
+		}
+	})
+}
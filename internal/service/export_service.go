@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type ExportService interface {
+	ExportBooks(ctx context.Context, queries web.QueryParamsExportBooks, w io.Writer) error
+}
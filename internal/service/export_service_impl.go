@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewExportService(uow UnitOfWork, validate *validator.Validate) ExportService {
+	return &ExportServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type ExportServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+// ExportBooks writes every book of the authenticated user to w while the query is read,
+// nothing is written when validation fails so the caller can still send an error response
+func (service *ExportServiceImpl) ExportBooks(ctx context.Context, queries web.QueryParamsExportBooks, w io.Writer) error {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of BookRepository
+	bookRepo := tx.GetBookRepository()
+
+	exportWriter := helper.NewBookExportWriter(queries.Format, w)
+
+	// Call repository, each row is written as soon as it is read
+	err = bookRepo.StreamAllWithAuthor(ctx, userId, exportWriter.WriteBook)
+	if err != nil {
+		return err
+	}
+
+	err = exportWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type GoalService interface {
+	CreateNewGoal(ctx context.Context, request web.CreateGoalRequest) (web.GoalResponse, error)
+	GetAllGoals(ctx context.Context, queries web.QueryParamsGetGoals) ([]web.GoalResponse, web.PaginationMeta, error)
+	GetGoalById(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalResponse, error)
+	UpdateGoalById(ctx context.Context, pathValues web.PathParamsGoal, request web.UpdateGoalRequest) (web.GoalResponse, error)
+	DeleteGoalById(ctx context.Context, pathValues web.PathParamsGoal) error
+	GetGoalProgress(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalProgressResponse, error)
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewGoalService(uow UnitOfWork, validate *validator.Validate) GoalService {
+	return &GoalServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type GoalServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *GoalServiceImpl) CreateNewGoal(ctx context.Context, request web.CreateGoalRequest) (web.GoalResponse, error) {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of GoalRepository
+	goalRepo := tx.GetGoalRepository()
+
+	startDate, endDate := helper.GoalPeriodDates(request.Period, request.Year, request.Quarter, request.Month)
+
+	// Check if the owner already has the same goal for the period
+	err = goalRepo.CheckByPeriod(ctx, userId, request.Metric, request.Period, startDate)
+	if err != nil {
+		return web.GoalResponse{}, goalExistsError(request.Metric, request.Period)
+	}
+
+	goal := domain.Goal{
+		OwnerId:   userId,
+		Metric:    request.Metric,
+		Period:    request.Period,
+		Target:    request.Target,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	// Call repository
+	goal, err = goalRepo.Save(ctx, goal)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	return helper.ToGoalResponse(goal), nil
+}
+
+func (service *GoalServiceImpl) GetAllGoals(ctx context.Context, queries web.QueryParamsGetGoals) ([]web.GoalResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.GoalResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.GoalResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.GoalResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	filter := domain.GoalFilter{
+		Metric: queries.Metric,
+		Year:   queries.Year,
+	}
+
+	// The most recent periods come first unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "-start_date")
+
+	// Call repository
+	goals, total, err := tx.GetGoalRepository().FindAll(ctx, userId, filter, page)
+	if err != nil {
+		return []web.GoalResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToGoalsResponse(goals), helper.ToPaginationMeta(page, total), nil
+}
+
+func (service *GoalServiceImpl) GetGoalById(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Call repository
+	goal, err := tx.GetGoalRepository().FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.GoalResponse{}, goalNotFoundError(pathValues.Id)
+		}
+		return web.GoalResponse{}, err
+	}
+
+	return helper.ToGoalResponse(goal), nil
+}
+
+func (service *GoalServiceImpl) UpdateGoalById(ctx context.Context, pathValues web.PathParamsGoal, request web.UpdateGoalRequest) (web.GoalResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of GoalRepository
+	goalRepo := tx.GetGoalRepository()
+
+	// Check if goal exists
+	goal, err := goalRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.GoalResponse{}, goalNotFoundError(pathValues.Id)
+		}
+		return web.GoalResponse{}, err
+	}
+
+	startDate, endDate := helper.GoalPeriodDates(request.Period, request.Year, request.Quarter, request.Month)
+
+	// Check if the owner already has the same goal for the period, the goal itself is left out
+	err = goalRepo.CheckByPeriod(ctx, userId, request.Metric, request.Period, startDate)
+	if err != nil {
+		if goal.Metric != request.Metric || goal.Period != request.Period || !goal.StartDate.Equal(startDate) {
+			return web.GoalResponse{}, goalExistsError(request.Metric, request.Period)
+		}
+		err = nil
+	}
+
+	goal = domain.Goal{
+		Id:        pathValues.Id,
+		OwnerId:   userId,
+		Metric:    request.Metric,
+		Period:    request.Period,
+		Target:    request.Target,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	// Call repository
+	goal, err = goalRepo.Update(ctx, goal)
+	if err != nil {
+		return web.GoalResponse{}, err
+	}
+
+	return helper.ToGoalResponse(goal), nil
+}
+
+func (service *GoalServiceImpl) DeleteGoalById(ctx context.Context, pathValues web.PathParamsGoal) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of GoalRepository
+	goalRepo := tx.GetGoalRepository()
+
+	// Check if goal exists
+	_, err = goalRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return goalNotFoundError(pathValues.Id)
+		}
+		return err
+	}
+
+	// Call repository
+	err = goalRepo.Delete(ctx, userId, pathValues.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *GoalServiceImpl) GetGoalProgress(ctx context.Context, pathValues web.PathParamsGoal) (web.GoalProgressResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.GoalProgressResponse{}, err
+	}
+
+	// Get the authenticated user, goals are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GoalProgressResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GoalProgressResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of GoalRepository
+	goalRepo := tx.GetGoalRepository()
+
+	// Check if goal exists
+	goal, err := goalRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.GoalProgressResponse{}, goalNotFoundError(pathValues.Id)
+		}
+		return web.GoalProgressResponse{}, err
+	}
+
+	// Progress is computed on every request so it follows the books and the reading sessions
+	totals, err := tx.GetStatsRepository().FindTotals(ctx, userId, goal.StartDate, goal.EndDate)
+	if err != nil {
+		return web.GoalProgressResponse{}, err
+	}
+
+	return helper.ToGoalProgressResponse(goal, totals, time.Now()), nil
+}
+
+func goalNotFoundError(goalId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "id",
+				Message: fmt.Sprintf("goal with id '%s' is not found", goalId),
+			},
+		},
+		fmt.Errorf("goal with id '%s' is not found", goalId),
+	)
+}
+
+func goalExistsError(metric, period string) error {
+	return appError.NewAppError(
+		http.StatusBadRequest,
+		[]appError.ErrAggregate{
+			{
+				Field:   "period",
+				Message: fmt.Sprintf("%s goal for this %s is already exists", metric, period),
+			},
+		},
+		nil,
+	)
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type HighlightService interface {
+	GetAllHighlights(ctx context.Context, pathValues web.PathParamsBookHighlights, queries web.QueryParamsGetHighlights) ([]web.HighlightResponse, web.PaginationMeta, error)
+}
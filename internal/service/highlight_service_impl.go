@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewHighlightService(uow UnitOfWork, validate *validator.Validate) HighlightService {
+	return &HighlightServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type HighlightServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *HighlightServiceImpl) GetAllHighlights(ctx context.Context, pathValues web.PathParamsBookHighlights, queries web.QueryParamsGetHighlights) ([]web.HighlightResponse, web.PaginationMeta, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Validate queries
+	err = service.Validate.Struct(queries)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, highlights belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Highlights follow the locations of the book unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "location")
+
+	// Call repository
+	highlights, total, err := tx.GetHighlightRepository().FindAllByBookId(ctx, pathValues.BookId, queries.Type, page)
+	if err != nil {
+		return []web.HighlightResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToHighlightsResponse(highlights), helper.ToPaginationMeta(page, total), nil
+}
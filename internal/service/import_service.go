@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type ImportService interface {
+	ImportBooks(ctx context.Context, queries web.QueryParamsImportBooks, file io.Reader) (web.ImportBooksResponse, error)
+	ImportKindleClippings(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error)
+	ImportKoboAnnotations(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error)
+}
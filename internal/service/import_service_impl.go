@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+// importedAuthorNationality matches the authors.nationality column default, exports do not carry it
+const importedAuthorNationality = "Unknown"
+
+func NewImportService(uow UnitOfWork, validate *validator.Validate) ImportService {
+	return &ImportServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type ImportServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *ImportServiceImpl) ImportBooks(ctx context.Context, queries web.QueryParamsImportBooks, file io.Reader) (web.ImportBooksResponse, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return web.ImportBooksResponse{}, err
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ImportBooksResponse{}, err
+	}
+
+	format, rows, err := helper.ParseShelfCSV(file)
+	if err != nil {
+		return web.ImportBooksResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "file",
+					Message: err.Error(),
+				},
+			},
+			err,
+		)
+	}
+
+	report := web.ImportBooksResponse{
+		Format: format,
+		Rows:   make([]web.ImportRowResponse, 0, len(rows)),
+	}
+
+	// Without batch_size the whole file is imported in one transaction
+	batchSize := queries.BatchSize
+	if batchSize == 0 {
+		batchSize = len(rows)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := min(start+batchSize, len(rows))
+
+		results, err := service.importBatch(ctx, userId, rows[start:end], queries.DefaultTotalPage)
+		if err != nil {
+			// The batch is rolled back, so none of its rows were stored
+			slog.Error("import batch rolled back", "err", err)
+			results = rolledBackRows(rows[start:end])
+		}
+
+		report.Rows = append(report.Rows, results...)
+	}
+
+	for _, row := range report.Rows {
+		switch row.Result {
+		case web.ImportResultCreated:
+			report.Created++
+		case web.ImportResultSkipped:
+			report.Skipped++
+		case web.ImportResultFailed:
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// importBatch stores rows inside a single transaction. Row level problems are reported in the results,
+// an error means the transaction was rolled back
+func (service *ImportServiceImpl) importBatch(ctx context.Context, userId string, rows []domain.ImportRow, defaultTotalPage int) (results []web.ImportRowResponse, err error) {
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates new instances of AuthorRepository, BookRepository and ReadRepository
+	authorRepo := tx.GetAuthorRepository()
+	bookRepo := tx.GetBookRepository()
+	readRepo := tx.GetReadRepository()
+
+	results = make([]web.ImportRowResponse, 0, len(rows))
+
+	for _, row := range rows {
+		result := web.ImportRowResponse{
+			Line:       row.Line,
+			Title:      row.Title,
+			AuthorName: row.AuthorName,
+			Result:     web.ImportResultFailed,
+		}
+
+		status, statusErr := helper.ShelfToStatus(row.Shelf)
+		if statusErr != nil {
+			result.Message = statusErr.Error()
+			results = append(results, result)
+			continue
+		}
+
+		totalPage := row.TotalPage
+		if totalPage == 0 {
+			totalPage = defaultTotalPage
+		}
+
+		// Exports often have no date for books read long ago, such a book counts as completed on the day it was
+		// added to the shelf and the row says so. A row without either date is not imported
+		completedDate, dateMessage := "", ""
+		if status == "completed" {
+			completedDate = row.DateRead
+			if completedDate == "" {
+				completedDate = row.DateAdded
+				dateMessage = "date read is missing, completed_date falls back to date added"
+			}
+			if completedDate == "" {
+				result.Message = "date read and date added are missing, a completed book needs one of them"
+				results = append(results, result)
+				continue
+			}
+		}
+
+		request := web.ImportBookRowRequest{
+			Name:          row.Title,
+			AuthorName:    row.AuthorName,
+			TotalPage:     totalPage,
+			Status:        status,
+			CompletedDate: completedDate,
+		}
+
+		// Validate the row
+		if validationErr := service.Validate.Struct(request); validationErr != nil {
+			result.Message = validationMessage(validationErr)
+			results = append(results, result)
+			continue
+		}
+
+		if dateErrs := completedDateErrors(request.Status, request.CompletedDate, time.Now()); len(dateErrs) != 0 {
+			result.Message = dateErrs[0].Message
+			results = append(results, result)
+			continue
+		}
+
+		// Find the author by full_name or create it
+		author, err := authorRepo.FindByFullName(ctx, request.AuthorName)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return nil, err
+			}
+
+			author, err = authorRepo.Save(ctx, domain.Author{
+				FullName:    request.AuthorName,
+				Nationality: importedAuthorNationality,
+			})
+			if err != nil {
+				return nil, err
+			}
+			result.AuthorCreated = true
+		}
+		result.AuthorId = author.Id
+
+		// Skip books that are already on the shelf
+		if checkErr := bookRepo.CheckByNameAndAuthorId(ctx, userId, request.Name, author.Id); checkErr != nil {
+			result.Result = web.ImportResultSkipped
+			result.Message = fmt.Sprintf("%v with author_id '%v' is already exists", request.Name, author.Id)
+			results = append(results, result)
+			continue
+		}
+
+		// A completed book has been read to the last page
+		currentPage := 0
+		if status == "completed" {
+			currentPage = totalPage
+		}
+
+		book, err := bookRepo.Save(ctx, domain.Book{
+			OwnerId:     userId,
+			Name:        request.Name,
+			TotalPage:   request.TotalPage,
+			CurrentPage: currentPage,
+			AuthorId:    author.Id,
+			Status:      request.Status,
+			Contributors: []domain.BookContributor{
+				{
+					AuthorId: author.Id,
+					FullName: author.FullName,
+					Role:     domain.ContributorRoleAuthor,
+				},
+			},
+			CompletedDate: request.CompletedDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// A book that is being read or has been read gets its first read
+		err = recordRead(ctx, readRepo, book.Id, "", book.Status, statusDate(book))
+		if err != nil {
+			return nil, err
+		}
+
+		result.Result = web.ImportResultCreated
+		result.Message = dateMessage
+		result.BookId = book.Id
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// ImportKindleClippings stores the highlights and notes of a Kindle My Clippings.txt file
+func (service *ImportServiceImpl) ImportKindleClippings(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error) {
+	return service.importClippings(ctx, domain.ImportFormatKindle, helper.ParseKindleClippings, file)
+}
+
+// ImportKoboAnnotations stores the highlights and notes of a Kobo .annot annotation export
+func (service *ImportServiceImpl) ImportKoboAnnotations(ctx context.Context, file io.Reader) (web.ImportHighlightsResponse, error) {
+	return service.importClippings(ctx, domain.ImportFormatKobo, helper.ParseKoboAnnotations, file)
+}
+
+// importClippings stores the clippings parsed from an e-reader file. Titles are matched to the books of the owner
+// by similarity since e-reader titles often carry a subtitle or a series name,
+// titles without a book are reported with a prefilled book request instead
+func (service *ImportServiceImpl) importClippings(ctx context.Context, format string, parse func(io.Reader) ([]domain.Clipping, error), file io.Reader) (report web.ImportHighlightsResponse, err error) {
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ImportHighlightsResponse{}, err
+	}
+
+	clippings, err := parse(file)
+	if err != nil {
+		return web.ImportHighlightsResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "file",
+					Message: err.Error(),
+				},
+			},
+			err,
+		)
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.ImportHighlightsResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates new instances of AuthorRepository, BookRepository and HighlightRepository
+	authorRepo := tx.GetAuthorRepository()
+	bookRepo := tx.GetBookRepository()
+	highlightRepo := tx.GetHighlightRepository()
+
+	report = web.ImportHighlightsResponse{
+		Format:    format,
+		Books:     []web.ImportHighlightsBookResponse{},
+		Unmatched: []web.UnmatchedClippingsResponse{},
+	}
+
+	// Every title is matched once, in the order it first appears in the file
+	type clippingTitle struct {
+		title      string
+		authorName string
+	}
+	matchedBooks := map[clippingTitle]int{}
+	unmatchedBooks := map[clippingTitle]int{}
+
+	for _, clipping := range clippings {
+		// Bookmarks and empty highlights have nothing to keep
+		if clipping.Kind == domain.ClippingKindBookmark || clipping.Kind == "" || clipping.Text == "" {
+			report.Skipped++
+			continue
+		}
+
+		key := clippingTitle{clipping.Title, clipping.AuthorName}
+
+		if i, ok := unmatchedBooks[key]; ok {
+			report.Unmatched[i].Clippings++
+			continue
+		}
+
+		i, ok := matchedBooks[key]
+		if !ok {
+			book, matchErr := bookRepo.FindBestMatch(ctx, userId, clipping.Title, clipping.AuthorName)
+			if matchErr != nil {
+				if !errors.Is(matchErr, sql.ErrNoRows) {
+					return web.ImportHighlightsResponse{}, matchErr
+				}
+
+				unmatched, err := unmatchedClippings(ctx, authorRepo, clipping)
+				if err != nil {
+					return web.ImportHighlightsResponse{}, err
+				}
+
+				unmatchedBooks[key] = len(report.Unmatched)
+				report.Unmatched = append(report.Unmatched, unmatched)
+				continue
+			}
+
+			i = len(report.Books)
+			matchedBooks[key] = i
+			report.Books = append(report.Books, web.ImportHighlightsBookResponse{
+				Title:      clipping.Title,
+				AuthorName: clipping.AuthorName,
+				BookId:     book.Id,
+				BookName:   book.Name,
+			})
+		}
+
+		var created bool
+		_, created, err = highlightRepo.SaveIfNotExists(ctx, domain.Highlight{
+			BookId:      report.Books[i].BookId,
+			Type:        clipping.Kind,
+			Text:        clipping.Text,
+			Location:    clipping.Location,
+			Page:        clipping.Page,
+			ClippedAt:   clipping.ClippedAt,
+			Fingerprint: helper.ClippingFingerprint(clipping),
+		})
+		if err != nil {
+			return web.ImportHighlightsResponse{}, err
+		}
+
+		// Clippings stored by an earlier import are counted as duplicates
+		if created {
+			report.Books[i].Imported++
+			report.Imported++
+		} else {
+			report.Books[i].Duplicates++
+			report.Duplicates++
+		}
+	}
+
+	return report, nil
+}
+
+// unmatchedClippings offers a title without a book as a new book, the author is filled in when it is already known
+func unmatchedClippings(ctx context.Context, authorRepo repository.AuthorRepository, clipping domain.Clipping) (web.UnmatchedClippingsResponse, error) {
+	unmatched := web.UnmatchedClippingsResponse{
+		Title:      clipping.Title,
+		AuthorName: clipping.AuthorName,
+		Clippings:  1,
+		Book: web.CreateBookRequest{
+			Name:   clipping.Title,
+			Status: "plan_to_read",
+		},
+	}
+
+	if clipping.AuthorName == "" {
+		return unmatched, nil
+	}
+
+	author, err := authorRepo.FindByFullName(ctx, clipping.AuthorName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return unmatched, nil
+		}
+		return web.UnmatchedClippingsResponse{}, err
+	}
+	unmatched.Book.AuthorId = author.Id
+
+	return unmatched, nil
+}
+
+func rolledBackRows(rows []domain.ImportRow) []web.ImportRowResponse {
+	results := make([]web.ImportRowResponse, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, web.ImportRowResponse{
+			Line:       row.Line,
+			Title:      row.Title,
+			AuthorName: row.AuthorName,
+			Result:     web.ImportResultFailed,
+			Message:    "batch was rolled back because of an internal error",
+		})
+	}
+
+	return results
+}
+
+// validationMessage joins translated validation errors into a single message
+func validationMessage(err error) string {
+	messages := []string{}
+	for _, e := range appError.TranslateValidationErrors(err) {
+		messages = append(messages, e["message"])
+	}
+
+	if len(messages) == 0 {
+		return err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+// MockAuthorRepository keeps authors in memory by id
+type MockAuthorRepository struct {
+	repository.AuthorRepository
+
+	Authors map[string]domain.Author
+}
+
+func (m *MockAuthorRepository) FindByFullName(ctx context.Context, fullName string) (domain.Author, error) {
+	for _, author := range m.Authors {
+		if author.FullName == fullName {
+			return author, nil
+		}
+	}
+
+	return domain.Author{}, pgx.ErrNoRows
+}
+
+func (m *MockAuthorRepository) Save(ctx context.Context, author domain.Author) (domain.Author, error) {
+	author.Id = uuid.NewString()
+	m.Authors[author.Id] = author
+
+	return author, nil
+}
+
+func (m *MockBookRepository) CheckByNameAndAuthorId(ctx context.Context, ownerId, name, authorId string) error {
+	return nil
+}
+
+func (m *MockBookRepository) Save(ctx context.Context, book domain.Book) (domain.Book, error) {
+	book.Id = uuid.NewString()
+	m.Books[book.Id] = book
+
+	return book, nil
+}
+
+// MockReadRepository keeps reads in memory in the order they are saved
+type MockReadRepository struct {
+	repository.ReadRepository
+
+	Reads []domain.Read
+}
+
+func (m *MockReadRepository) Save(ctx context.Context, read domain.Read) (domain.Read, error) {
+	read.Id = uuid.NewString()
+	m.Reads = append(m.Reads, read)
+
+	return read, nil
+}
+
+func (m *MockReadRepository) FindInProgress(ctx context.Context, bookId string) (domain.Read, error) {
+	for _, read := range m.Reads {
+		if read.BookId == bookId && read.Outcome == "" {
+			return read, nil
+		}
+	}
+
+	return domain.Read{}, pgx.ErrNoRows
+}
+
+func TestImportBooksCompletedDate(t *testing.T) {
+	const csv = "Book Id,Title,Author,Number of Pages,Exclusive Shelf,Date Read,Date Added\n" +
+		"1,Dune,Frank Herbert,412,read,,2024/03/01\n" +
+		"2,Hyperion,Dan Simmons,482,read,2024/01/05,2023/12/01\n" +
+		"3,Solaris,Stanislaw Lem,204,read,,\n"
+
+	bookRepo := &MockBookRepository{Books: map[string]domain.Book{}}
+	readRepo := &MockReadRepository{}
+	uow := &MockUnitOfWork{Tx: &MockTransaction{
+		AuthorRepo: &MockAuthorRepository{Authors: map[string]domain.Author{}},
+		BookRepo:   bookRepo,
+		ReadRepo:   readRepo,
+	}}
+	service := NewImportService(uow, config.ValidatorInit())
+
+	report, err := service.ImportBooks(helper.WithUserId(context.Background(), ownerA), web.QueryParamsImportBooks{}, strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+
+	if report.Created != 2 || len(report.Rows) != 3 {
+		t.Fatalf("expected 2 created books of 3 rows but got %d: %+v", report.Created, report.Rows)
+	}
+
+	dune, hyperion := bookRepo.Books[report.Rows[0].BookId], bookRepo.Books[report.Rows[1].BookId]
+
+	finishedDates := map[string]string{}
+	for _, read := range readRepo.Reads {
+		finishedDates[read.BookId] = read.FinishedDate
+	}
+
+	t.Run("completed without date read", func(t *testing.T) {
+		if dune.Status != "completed" || dune.CompletedDate != "2024-03-01" {
+			t.Errorf("expected completed on the date added 2024-03-01 but got %s on '%s'", dune.Status, dune.CompletedDate)
+		}
+
+		if !strings.Contains(report.Rows[0].Message, "falls back to date added") {
+			t.Errorf("expected the row to say the date added is used but got '%s'", report.Rows[0].Message)
+		}
+
+		if finishedDates[dune.Id] != "2024-03-01" {
+			t.Errorf("expected a read finished on 2024-03-01 but got '%s'", finishedDates[dune.Id])
+		}
+	})
+
+	t.Run("completed with date read", func(t *testing.T) {
+		if hyperion.CompletedDate != "2024-01-05" {
+			t.Errorf("expected completed_date 2024-01-05 but got '%s'", hyperion.CompletedDate)
+		}
+
+		if finishedDates[hyperion.Id] != "2024-01-05" {
+			t.Errorf("expected a read finished on 2024-01-05 but got '%s'", finishedDates[hyperion.Id])
+		}
+
+		if report.Rows[1].Message != "" {
+			t.Errorf("expected no message but got '%s'", report.Rows[1].Message)
+		}
+	})
+
+	t.Run("completed without any date", func(t *testing.T) {
+		row := report.Rows[2]
+		if row.Result != web.ImportResultFailed || row.BookId != "" {
+			t.Errorf("expected the row to fail without a book but got %s with book '%s'", row.Result, row.BookId)
+		}
+
+		if !strings.Contains(row.Message, "date read and date added are missing") {
+			t.Errorf("expected the row to say both dates are missing but got '%s'", row.Message)
+		}
+	})
+}
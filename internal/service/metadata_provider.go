@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+)
+
+// ErrMetadataNotFound is returned by a MetadataProvider that does not know the ISBN
+var ErrMetadataNotFound = errors.New("book metadata is not found")
+
+// MetadataProvider looks up edition metadata by ISBN in an external catalog
+type MetadataProvider interface {
+	LookupByISBN(ctx context.Context, isbn string) (domain.BookMetadata, error)
+}
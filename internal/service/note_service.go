@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type NoteService interface {
+	CreateNewNote(ctx context.Context, pathValues web.PathParamsBookNotes, request web.CreateNoteRequest) (web.NoteResponse, error)
+	GetAllNotes(ctx context.Context, pathValues web.PathParamsBookNotes, queries web.QueryParamsGetNotes) ([]web.NoteResponse, web.PaginationMeta, error)
+	GetNoteById(ctx context.Context, pathValues web.PathParamsBookNote) (web.NoteResponse, error)
+	UpdateNoteById(ctx context.Context, pathValues web.PathParamsBookNote, request web.UpdateNoteRequest) (web.NoteResponse, error)
+	DeleteNoteById(ctx context.Context, pathValues web.PathParamsBookNote) error
+	SearchNotes(ctx context.Context, queries web.QueryParamsSearchNotes) ([]web.NoteSearchResultResponse, web.PaginationMeta, error)
+}
@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewNoteService(uow UnitOfWork, validate *validator.Validate) NoteService {
+	return &NoteServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type NoteServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *NoteServiceImpl) CreateNewNote(ctx context.Context, pathValues web.PathParamsBookNotes, request web.CreateNoteRequest) (web.NoteResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Get the authenticated user, notes belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	book, err := findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Check if page is within the book
+	errAggregate := notePageErrors(request.Page, book.TotalPage)
+	if len(errAggregate) != 0 {
+		return web.NoteResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	note := domain.Note{
+		BookId: book.Id,
+		Type:   request.Type,
+		Text:   request.Text,
+		Page:   request.Page,
+	}
+
+	// Call repository
+	note, err = tx.GetNoteRepository().Save(ctx, note)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	return helper.ToNoteResponse(note), nil
+}
+
+func (service *NoteServiceImpl) GetAllNotes(ctx context.Context, pathValues web.PathParamsBookNotes, queries web.QueryParamsGetNotes) ([]web.NoteResponse, web.PaginationMeta, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Validate queries
+	err = service.Validate.Struct(queries)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, notes belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Notes follow the pages of the book unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "page")
+
+	// Call repository
+	notes, total, err := tx.GetNoteRepository().FindAllByBookId(ctx, pathValues.BookId, queries.Type, page)
+	if err != nil {
+		return []web.NoteResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToNotesResponse(notes), helper.ToPaginationMeta(page, total), nil
+}
+
+func (service *NoteServiceImpl) GetNoteById(ctx context.Context, pathValues web.PathParamsBookNote) (web.NoteResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Get the authenticated user, notes belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Call repository
+	note, err := tx.GetNoteRepository().FindById(ctx, pathValues.BookId, pathValues.NoteId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.NoteResponse{}, noteNotFoundError(pathValues.NoteId)
+		}
+		return web.NoteResponse{}, err
+	}
+
+	return helper.ToNoteResponse(note), nil
+}
+
+func (service *NoteServiceImpl) UpdateNoteById(ctx context.Context, pathValues web.PathParamsBookNote, request web.UpdateNoteRequest) (web.NoteResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Get the authenticated user, notes belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	book, err := findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	// It creates a new instance of NoteRepository
+	noteRepo := tx.GetNoteRepository()
+
+	// Check if id is exists
+	note, err := noteRepo.FindById(ctx, pathValues.BookId, pathValues.NoteId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// If id is not found, return earlier
+			return web.NoteResponse{}, noteNotFoundError(pathValues.NoteId)
+		}
+		return web.NoteResponse{}, err
+	}
+
+	// Check if page is within the book
+	errAggregate := notePageErrors(request.Page, book.TotalPage)
+	if len(errAggregate) != 0 {
+		return web.NoteResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	note.Type = request.Type
+	note.Text = request.Text
+	note.Page = request.Page
+
+	// Call repository
+	note, err = noteRepo.Update(ctx, note)
+	if err != nil {
+		return web.NoteResponse{}, err
+	}
+
+	return helper.ToNoteResponse(note), nil
+}
+
+func (service *NoteServiceImpl) DeleteNoteById(ctx context.Context, pathValues web.PathParamsBookNote) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, notes belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return err
+	}
+
+	// It creates a new instance of NoteRepository
+	noteRepo := tx.GetNoteRepository()
+
+	// Check if id is exists
+	_, err = noteRepo.FindById(ctx, pathValues.BookId, pathValues.NoteId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// if id not found, return earlier
+			return noteNotFoundError(pathValues.NoteId)
+		}
+		return err
+	}
+
+	// Call repository
+	err = noteRepo.Delete(ctx, pathValues.NoteId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *NoteServiceImpl) SearchNotes(ctx context.Context, queries web.QueryParamsSearchNotes) ([]web.NoteSearchResultResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.NoteSearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, only the notes of their own books are searched
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.NoteSearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.NoteSearchResultResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Results are always ordered by relevance
+	page := helper.ToPage(queries.Page, queries.PageSize, "", "")
+
+	// Call repository
+	results, total, err := tx.GetNoteRepository().Search(ctx, userId, queries.Query, queries.Type, page)
+	if err != nil {
+		return []web.NoteSearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToNoteSearchResultsResponse(results), helper.ToPaginationMeta(page, total), nil
+}
+
+// notePageErrors checks the page reference of a note against the length of the book
+func notePageErrors(page, totalPage int) []appError.ErrAggregate {
+	if page > totalPage {
+		return []appError.ErrAggregate{{
+			Field:   "page",
+			Message: fmt.Sprintf("page must not be greater than total_page (%d)", totalPage),
+		}}
+	}
+
+	return nil
+}
+
+func noteNotFoundError(noteId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "note_id",
+				Message: fmt.Sprintf("note with id '%s' is not found", noteId),
+			},
+		},
+		fmt.Errorf("note with id '%s' is not found", noteId),
+	)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type ReadService interface {
+	StartRead(ctx context.Context, pathValues web.PathParamsBookReads, request web.StartReadRequest) (web.ChangeReadResponse, error)
+	FinishRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error)
+	AbandonRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error)
+	GetAllReads(ctx context.Context, pathValues web.PathParamsBookReads) ([]web.ReadResponse, error)
+}
@@ -0,0 +1,434 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+func NewReadService(uow UnitOfWork, validate *validator.Validate) ReadService {
+	return &ReadServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type ReadServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *ReadServiceImpl) StartRead(ctx context.Context, pathValues web.PathParamsBookReads, request web.StartReadRequest) (web.ChangeReadResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// Get the authenticated user, reads belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	today := time.Now().Format("2006-01-02")
+
+	startedDate := request.StartedDate
+	if startedDate == "" {
+		startedDate = today
+	}
+
+	// Dates are already validated as YYYY-MM-DD so they compare as strings
+	if startedDate > today {
+		return web.ChangeReadResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "started_date",
+					Message: "started_date must not be in the future",
+				},
+			},
+			nil,
+		)
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	book, err := findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// It creates a new instance of ReadRepository
+	readRepo := tx.GetReadRepository()
+
+	// Check if the book is already being read
+	_, err = readRepo.FindInProgress(ctx, book.Id)
+	if err == nil {
+		return web.ChangeReadResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			[]appError.ErrAggregate{
+				{
+					Field:   "id",
+					Message: fmt.Sprintf("book with id '%s' already has a read in progress", book.Id),
+				},
+			},
+			nil,
+		)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return web.ChangeReadResponse{}, err
+	}
+
+	read := domain.Read{
+		BookId:      book.Id,
+		StartedDate: startedDate,
+	}
+
+	// Call repository
+	read, err = readRepo.Save(ctx, read)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// A re-read starts over from the first page
+	if book.Status == "completed" {
+		book.CurrentPage = 0
+	}
+
+	book, err = syncBookWithReads(ctx, tx, userId, book)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	return helper.ToChangeReadResponse(read, book), nil
+}
+
+func (service *ReadServiceImpl) FinishRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error) {
+	return service.endRead(ctx, pathValues, request, domain.ReadOutcomeFinished)
+}
+
+func (service *ReadServiceImpl) AbandonRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest) (web.ChangeReadResponse, error) {
+	return service.endRead(ctx, pathValues, request, domain.ReadOutcomeAbandoned)
+}
+
+func (service *ReadServiceImpl) GetAllReads(ctx context.Context, pathValues web.PathParamsBookReads) ([]web.ReadResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.ReadResponse{}, err
+	}
+
+	// Get the authenticated user, reads belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.ReadResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.ReadResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return []web.ReadResponse{}, err
+	}
+
+	// Call repository
+	reads, err := tx.GetReadRepository().FindAllByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		return []web.ReadResponse{}, err
+	}
+
+	return helper.ToReadsResponse(reads), nil
+}
+
+// endRead gives the read in progress its outcome, a finished read leaves the book on its last page
+func (service *ReadServiceImpl) endRead(ctx context.Context, pathValues web.PathParamsBookRead, request web.EndReadRequest, outcome string) (web.ChangeReadResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// Get the authenticated user, reads belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	book, err := findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	// It creates a new instance of ReadRepository
+	readRepo := tx.GetReadRepository()
+
+	// Check if read is exists
+	read, err := readRepo.FindById(ctx, book.Id, pathValues.ReadId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.ChangeReadResponse{}, readNotFoundError(pathValues.ReadId)
+		}
+		return web.ChangeReadResponse{}, err
+	}
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	if read.Outcome != "" {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "read_id",
+			Message: fmt.Sprintf("read with id '%s' is already %s", read.Id, read.Outcome),
+		})
+	}
+
+	today := time.Now().Format("2006-01-02")
+
+	finishedDate := request.FinishedDate
+	if finishedDate == "" {
+		finishedDate = today
+	}
+
+	// Dates are already validated as YYYY-MM-DD so they compare as strings
+	if finishedDate > today {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "finished_date",
+			Message: "finished_date must not be in the future",
+		})
+	} else if finishedDate < read.StartedDate {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "finished_date",
+			Message: fmt.Sprintf("finished_date must not be before started_date (%s)", read.StartedDate),
+		})
+	}
+
+	if len(errAggregate) != 0 {
+		return web.ChangeReadResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	read.FinishedDate = finishedDate
+	read.Outcome = outcome
+	read.Rating = request.Rating
+
+	// Call repository
+	read, err = readRepo.Update(ctx, read)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	if outcome == domain.ReadOutcomeFinished {
+		book.CurrentPage = book.TotalPage
+	}
+
+	book, err = syncBookWithReads(ctx, tx, userId, book)
+	if err != nil {
+		return web.ChangeReadResponse{}, err
+	}
+
+	return helper.ToChangeReadResponse(read, book), nil
+}
+
+// syncBookWithReads derives status and completed_date of a book from its latest read and saves them
+// with the current_page of the book
+func syncBookWithReads(ctx context.Context, tx Transaction, userId string, book domain.Book) (domain.Book, error) {
+	reads, err := tx.GetReadRepository().FindAllByBookId(ctx, book.Id)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	if status, completedDate, ok := helper.BookStatusFromReads(reads); ok {
+		book.Status = status
+		book.CompletedDate = completedDate
+	}
+
+	err = tx.GetBookRepository().UpdateProgress(ctx, userId, book.Id, book)
+	if err != nil {
+		return domain.Book{}, err
+	}
+
+	return book, nil
+}
+
+// recordRead keeps the reads of a book in line with a status set on the book itself or by a reading session.
+// completed finishes the read in progress on date, corrects the latest finished read when the book was already
+// completed or records a read started and finished on date. reading starts a read on date unless one is in
+// progress, and plan_to_read abandons the read in progress on date. A completed book with a finished read does
+// not go back to plan_to_read since the finished read would complete it again
+func recordRead(ctx context.Context, readRepo repository.ReadRepository, bookId, previousStatus, status, date string) error {
+	read, err := readRepo.FindInProgress(ctx, bookId)
+	inProgress := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	switch status {
+	case "completed":
+		if !inProgress && previousStatus == "completed" {
+			reads, err := readRepo.FindAllByBookId(ctx, bookId)
+			if err != nil {
+				return err
+			}
+
+			if len(reads) != 0 && reads[0].Outcome == domain.ReadOutcomeFinished {
+				read, inProgress = reads[0], true
+			}
+		}
+
+		if !inProgress {
+			_, err = readRepo.Save(ctx, domain.Read{
+				BookId:       bookId,
+				StartedDate:  date,
+				FinishedDate: date,
+				Outcome:      domain.ReadOutcomeFinished,
+			})
+			return err
+		}
+
+		read.Outcome = domain.ReadOutcomeFinished
+	case "reading":
+		if inProgress {
+			return nil
+		}
+
+		_, err = readRepo.Save(ctx, domain.Read{
+			BookId:      bookId,
+			StartedDate: date,
+		})
+		return err
+	default:
+		if !inProgress {
+			if previousStatus != "completed" {
+				return nil
+			}
+
+			reads, err := readRepo.FindAllByBookId(ctx, bookId)
+			if err != nil {
+				return err
+			}
+
+			if len(reads) != 0 && reads[0].Outcome == domain.ReadOutcomeFinished {
+				return appError.NewAppError(
+					http.StatusBadRequest,
+					[]appError.ErrAggregate{
+						{
+							Field:   "status",
+							Message: fmt.Sprintf("status can not change from 'completed' to '%s' once the book has a finished read, start a new read to read it again", status),
+						},
+					},
+					nil,
+				)
+			}
+
+			return nil
+		}
+
+		read.Outcome = domain.ReadOutcomeAbandoned
+	}
+
+	// A read can not end before it started
+	read.FinishedDate = date
+	if read.StartedDate > date {
+		read.StartedDate = date
+	}
+
+	_, err = readRepo.Update(ctx, read)
+	return err
+}
+
+// statusDate is the day the status of a book took effect, completed_date for a completed book and today otherwise
+func statusDate(book domain.Book) string {
+	if book.Status == "completed" {
+		return book.CompletedDate
+	}
+
+	return time.Now().Format("2006-01-02")
+}
+
+func readNotFoundError(readId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "read_id",
+				Message: fmt.Sprintf("read with id '%s' is not found", readId),
+			},
+		},
+		fmt.Errorf("read with id '%s' is not found", readId),
+	)
+}
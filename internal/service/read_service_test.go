@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+// FindAllByBookId orders the reads like the SQL does, the read in progress first and then the latest to finish
+func (m *MockReadRepository) FindAllByBookId(ctx context.Context, bookId string) ([]domain.Read, error) {
+	reads := []domain.Read{}
+	for _, read := range m.Reads {
+		if read.BookId == bookId {
+			reads = append(reads, read)
+		}
+	}
+
+	sort.SliceStable(reads, func(i, j int) bool {
+		if (reads[i].FinishedDate == "") != (reads[j].FinishedDate == "") {
+			return reads[i].FinishedDate == ""
+		}
+		return reads[i].FinishedDate > reads[j].FinishedDate
+	})
+
+	return reads, nil
+}
+
+func (m *MockBookRepository) UpdateProgress(ctx context.Context, ownerId, bookId string, book domain.Book) error {
+	m.Books[bookId] = book
+
+	return nil
+}
+
+func TestReadStatus(t *testing.T) {
+	completedBook := domain.Book{
+		Id:            "43723811-c8e3-4cba-85cc-142954064ae4",
+		OwnerId:       ownerA,
+		Name:          "Laut Bercerita",
+		Status:        "completed",
+		CompletedDate: "2024-02-10",
+	}
+	finishedRead := domain.Read{
+		Id:           "9b2f6c1e-4d3a-4e8b-a7c6-5f4e3d2c1b0a",
+		BookId:       completedBook.Id,
+		StartedDate:  "2024-01-03",
+		FinishedDate: "2024-02-10",
+		Outcome:      domain.ReadOutcomeFinished,
+	}
+
+	t.Run("backdated read in progress", func(t *testing.T) {
+		bookRepo := &MockBookRepository{Books: map[string]domain.Book{completedBook.Id: completedBook}}
+		readRepo := &MockReadRepository{Reads: []domain.Read{finishedRead}}
+		uow := &MockUnitOfWork{Tx: &MockTransaction{BookRepo: bookRepo, ReadRepo: readRepo}}
+		service := NewReadService(uow, config.ValidatorInit())
+
+		// The re-read started before the finished read ended
+		_, err := service.StartRead(helper.WithUserId(context.Background(), ownerA), web.PathParamsBookReads{BookId: completedBook.Id}, web.StartReadRequest{
+			StartedDate: "2023-12-20",
+		})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if book := bookRepo.Books[completedBook.Id]; book.Status != "reading" || book.CompletedDate != "" {
+			t.Errorf("expected the book to be reading but got %s on '%s'", book.Status, book.CompletedDate)
+		}
+	})
+
+	t.Run("completed back to plan to read", func(t *testing.T) {
+		readRepo := &MockReadRepository{Reads: []domain.Read{finishedRead}}
+
+		err := recordRead(context.Background(), readRepo, completedBook.Id, "completed", "plan_to_read", "2024-03-01")
+		expectStatus(t, err, http.StatusBadRequest)
+
+		if len(readRepo.Reads) != 1 || readRepo.Reads[0] != finishedRead {
+			t.Errorf("expected the finished read to be kept but got %+v", readRepo.Reads)
+		}
+	})
+
+	t.Run("completed without a read back to plan to read", func(t *testing.T) {
+		readRepo := &MockReadRepository{}
+
+		err := recordRead(context.Background(), readRepo, completedBook.Id, "completed", "plan_to_read", "2024-03-01")
+		if err != nil {
+			t.Errorf("expected no error but got %v", err)
+		}
+	})
+}
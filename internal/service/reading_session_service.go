@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type ReadingSessionService interface {
+	CreateNewSession(ctx context.Context, pathValues web.PathParamsCreateReadingSession, request web.CreateReadingSessionRequest) (web.CreateReadingSessionResponse, error)
+	GetAllSessions(ctx context.Context, pathValues web.PathParamsGetReadingSessions) ([]web.ReadingSessionResponse, error)
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewReadingSessionService(uow UnitOfWork, validate *validator.Validate) ReadingSessionService {
+	return &ReadingSessionServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type ReadingSessionServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *ReadingSessionServiceImpl) CreateNewSession(ctx context.Context, pathValues web.PathParamsCreateReadingSession, request web.CreateReadingSessionRequest) (web.CreateReadingSessionResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of BookRepository and ReadingSessionRepository
+	bookRepo := tx.GetBookRepository()
+	sessionRepo := tx.GetReadingSessionRepository()
+
+	// Check if book exists
+	book, err := bookRepo.FindById(ctx, userId, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "id",
+				Message: fmt.Sprintf("book with id '%s' is not found", pathValues.BookId),
+			})
+
+			// If id is not found, return earlier
+			return web.CreateReadingSessionResponse{}, appError.NewAppError(
+				http.StatusNotFound,
+				errAggregate,
+				fmt.Errorf("book with id '%v' is not found", pathValues.BookId),
+			)
+		}
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	// A session that completes the book becomes its completed_date, which must not be in the future
+	if request.SessionDate > time.Now().Format("2006-01-02") {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "session_date",
+			Message: "session_date must not be in the future",
+		})
+	}
+
+	// Check if pages are within the book
+	if request.StartPage > request.EndPage {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "start_page",
+			Message: fmt.Sprintf("start_page must not be greater than end_page (%d)", request.EndPage),
+		})
+	}
+	if request.EndPage > book.TotalPage {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "end_page",
+			Message: fmt.Sprintf("end_page must not be greater than total_page (%d)", book.TotalPage),
+		})
+	}
+
+	if len(errAggregate) != 0 {
+		return web.CreateReadingSessionResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	session := domain.ReadingSession{
+		BookId:      book.Id,
+		SessionDate: request.SessionDate,
+		StartPage:   request.StartPage,
+		EndPage:     request.EndPage,
+		MinutesRead: request.MinutesRead,
+	}
+
+	// Call repository
+	session, err = sessionRepo.Save(ctx, session)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	// Progress only moves forward, an older session does not rewind current_page
+	if request.EndPage > book.CurrentPage {
+		book.CurrentPage = request.EndPage
+	}
+
+	// Logging a session starts the book, reaching the last page completes it
+	previousStatus := book.Status
+	if book.CurrentPage >= book.TotalPage {
+		if book.Status != "completed" {
+			book.Status = "completed"
+			book.CompletedDate = request.SessionDate
+		}
+	} else if book.Status == "plan_to_read" {
+		book.Status = "reading"
+	}
+
+	// The read in progress follows the status, it starts or ends on the day of the session
+	if book.Status != previousStatus {
+		err = recordRead(ctx, tx.GetReadRepository(), book.Id, previousStatus, book.Status, request.SessionDate)
+		if err != nil {
+			return web.CreateReadingSessionResponse{}, err
+		}
+	}
+
+	err = bookRepo.UpdateProgress(ctx, userId, book.Id, book)
+	if err != nil {
+		return web.CreateReadingSessionResponse{}, err
+	}
+
+	return helper.ToCreateReadingSessionResponse(session, book), nil
+}
+
+func (service *ReadingSessionServiceImpl) GetAllSessions(ctx context.Context, pathValues web.PathParamsGetReadingSessions) ([]web.ReadingSessionResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.ReadingSessionResponse{}, err
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.ReadingSessionResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.ReadingSessionResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of BookRepository and ReadingSessionRepository
+	bookRepo := tx.GetBookRepository()
+	sessionRepo := tx.GetReadingSessionRepository()
+
+	// Check if book exists
+	_, err = bookRepo.FindById(ctx, userId, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "id",
+				Message: fmt.Sprintf("book with id '%s' is not found", pathValues.BookId),
+			})
+
+			return []web.ReadingSessionResponse{}, appError.NewAppError(
+				http.StatusNotFound,
+				errAggregate,
+				fmt.Errorf("book with id '%v' is not found", pathValues.BookId),
+			)
+		}
+		return []web.ReadingSessionResponse{}, err
+	}
+
+	// Call repository
+	sessions, err := sessionRepo.FindAllByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		return []web.ReadingSessionResponse{}, err
+	}
+
+	// No records return []
+	if len(sessions) == 0 {
+		return []web.ReadingSessionResponse{}, nil
+	}
+
+	return helper.ToReadingSessionsResponse(sessions), nil
+}
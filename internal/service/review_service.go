@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type ReviewService interface {
+	PutBookReview(ctx context.Context, pathValues web.PathParamsBookReview, request web.PutReviewRequest) (web.ReviewResponse, error)
+	GetBookReview(ctx context.Context, pathValues web.PathParamsBookReview) (web.ReviewResponse, error)
+	GetBookReviewHistory(ctx context.Context, pathValues web.PathParamsBookReview) ([]web.ReviewRevisionResponse, error)
+	DeleteBookReview(ctx context.Context, pathValues web.PathParamsBookReview) error
+	GetAuthorRatings(ctx context.Context, queries web.QueryParamsGetAuthorRatings) ([]web.AuthorRatingResponse, web.PaginationMeta, error)
+}
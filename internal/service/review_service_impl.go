@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewReviewService(uow UnitOfWork, validate *validator.Validate) ReviewService {
+	return &ReviewServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type ReviewServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *ReviewServiceImpl) PutBookReview(ctx context.Context, pathValues web.PathParamsBookReview, request web.PutReviewRequest) (web.ReviewResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Get the authenticated user, reviews belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// It creates a new instance of ReviewRepository
+	reviewRepo := tx.GetReviewRepository()
+
+	review, err := reviewRepo.FindByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return web.ReviewResponse{}, err
+		}
+
+		// The first review of the book
+		review = domain.Review{
+			BookId: pathValues.BookId,
+			Rating: request.Rating,
+			Body:   request.Body,
+		}
+
+		review, err = reviewRepo.Save(ctx, review)
+		if err != nil {
+			return web.ReviewResponse{}, err
+		}
+
+		return helper.ToReviewResponse(review), nil
+	}
+
+	// Saving the same review again does not add a revision
+	if review.Rating == request.Rating && review.Body == request.Body {
+		return helper.ToReviewResponse(review), nil
+	}
+
+	review.Rating = request.Rating
+	review.Body = request.Body
+
+	// Call repository, the replaced version is kept in the history
+	review, err = reviewRepo.Update(ctx, review)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	return helper.ToReviewResponse(review), nil
+}
+
+func (service *ReviewServiceImpl) GetBookReview(ctx context.Context, pathValues web.PathParamsBookReview) (web.ReviewResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Get the authenticated user, reviews belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return web.ReviewResponse{}, err
+	}
+
+	// Call repository
+	review, err := tx.GetReviewRepository().FindByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.ReviewResponse{}, reviewNotFoundError(pathValues.BookId)
+		}
+		return web.ReviewResponse{}, err
+	}
+
+	return helper.ToReviewResponse(review), nil
+}
+
+func (service *ReviewServiceImpl) GetBookReviewHistory(ctx context.Context, pathValues web.PathParamsBookReview) ([]web.ReviewRevisionResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.ReviewRevisionResponse{}, err
+	}
+
+	// Get the authenticated user, reviews belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.ReviewRevisionResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.ReviewRevisionResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return []web.ReviewRevisionResponse{}, err
+	}
+
+	// It creates a new instance of ReviewRepository
+	reviewRepo := tx.GetReviewRepository()
+
+	review, err := reviewRepo.FindByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []web.ReviewRevisionResponse{}, reviewNotFoundError(pathValues.BookId)
+		}
+		return []web.ReviewRevisionResponse{}, err
+	}
+
+	// Call repository
+	revisions, err := reviewRepo.FindRevisions(ctx, review.Id)
+	if err != nil {
+		return []web.ReviewRevisionResponse{}, err
+	}
+
+	return helper.ToReviewRevisionResponses(revisions), nil
+}
+
+func (service *ReviewServiceImpl) DeleteBookReview(ctx context.Context, pathValues web.PathParamsBookReview) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, reviews belong to the owner of the book
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if book is exists
+	_, err = findOwnedBook(ctx, tx, userId, pathValues.BookId)
+	if err != nil {
+		return err
+	}
+
+	// It creates a new instance of ReviewRepository
+	reviewRepo := tx.GetReviewRepository()
+
+	review, err := reviewRepo.FindByBookId(ctx, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return reviewNotFoundError(pathValues.BookId)
+		}
+		return err
+	}
+
+	// Call repository, the history goes with the review
+	err = reviewRepo.Delete(ctx, review.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *ReviewServiceImpl) GetAuthorRatings(ctx context.Context, queries web.QueryParamsGetAuthorRatings) ([]web.AuthorRatingResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.AuthorRatingResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, only their own reviews are aggregated
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.AuthorRatingResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.AuthorRatingResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// The best rated authors come first unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "-average")
+
+	// Call repository
+	ratings, total, err := tx.GetReviewRepository().FindAuthorRatings(ctx, userId, page)
+	if err != nil {
+		return []web.AuthorRatingResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToAuthorRatingResponses(ratings), helper.ToPaginationMeta(page, total), nil
+}
+
+func reviewNotFoundError(bookId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "id",
+				Message: fmt.Sprintf("book with id '%s' has no review", bookId),
+			},
+		},
+		fmt.Errorf("book with id '%s' has no review", bookId),
+	)
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, queries web.QueryParamsSearch) ([]web.SearchResultResponse, web.PaginationMeta, error)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewSearchService(uow UnitOfWork, validate *validator.Validate) SearchService {
+	return &SearchServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type SearchServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *SearchServiceImpl) Search(ctx context.Context, queries web.QueryParamsSearch) ([]web.SearchResultResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.SearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, books are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.SearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.SearchResultResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of SearchRepository
+	searchRepo := tx.GetSearchRepository()
+
+	scope := queries.Type
+	if scope == "" {
+		scope = domain.SearchScopeAll
+	}
+
+	// Results are always ordered by relevance
+	page := helper.ToPage(queries.Page, queries.PageSize, "", "")
+
+	// Call repository
+	results, total, err := searchRepo.Search(ctx, userId, queries.Query, scope, page)
+	if err != nil {
+		return []web.SearchResultResponse{}, web.PaginationMeta{}, err
+	}
+
+	return helper.ToSearchResultsResponse(results), helper.ToPaginationMeta(page, total), nil
+}
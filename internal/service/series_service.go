@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type SeriesService interface {
+	CreateNewSeries(ctx context.Context, request web.CreateSeriesRequest) (web.CreateSeriesResponse, error)
+	GetAllSeries(ctx context.Context, queries web.QueryParamsGetSeries) ([]web.GetSeriesResponse, web.PaginationMeta, error)
+	GetSeriesById(ctx context.Context, pathValues web.PathParamsGetSeries) (web.GetSeriesResponse, error)
+	UpdateSeriesById(ctx context.Context, pathValues web.PathParamsUpdateSeries, request web.UpdateSeriesRequest) (web.UpdateSeriesResponse, error)
+	DeleteSeriesById(ctx context.Context, pathValues web.PathParamsDeleteSeries) error
+	GetSeriesBooks(ctx context.Context, pathValues web.PathParamsGetSeries) ([]web.SeriesVolumeResponse, error)
+	GetNextUnreadInSeries(ctx context.Context, pathValues web.PathParamsGetSeries) (web.SeriesVolumeResponse, error)
+}
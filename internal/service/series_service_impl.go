@@ -0,0 +1,494 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewSeriesService(uow UnitOfWork, validate *validator.Validate) SeriesService {
+	return &SeriesServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type SeriesServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *SeriesServiceImpl) CreateNewSeries(ctx context.Context, request web.CreateSeriesRequest) (web.CreateSeriesResponse, error) {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.CreateSeriesResponse{}, err
+	}
+
+	// Get the authenticated user, series are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.CreateSeriesResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.CreateSeriesResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of SeriesRepository
+	seriesRepo := tx.GetSeriesRepository()
+
+	// Check if author exists
+	authorNotFound, err := findSeriesAuthor(ctx, tx, request.AuthorId)
+	if err != nil {
+		return web.CreateSeriesResponse{}, err
+	}
+	if len(authorNotFound) != 0 {
+		return web.CreateSeriesResponse{}, appError.NewAppError(
+			http.StatusNotFound,
+			authorNotFound,
+			nil,
+		)
+	}
+
+	// Check if the author already has a series with the same name
+	err = seriesRepo.CheckByNameAndAuthorId(ctx, userId, request.Name, request.AuthorId)
+	if err != nil {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "name",
+			Message: fmt.Sprintf("series %s is already exists", request.Name),
+		})
+	}
+
+	if len(errAggregate) != 0 {
+		return web.CreateSeriesResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	series := domain.Series{
+		OwnerId:  userId,
+		Name:     request.Name,
+		AuthorId: request.AuthorId,
+	}
+
+	// Call repository
+	series, err = seriesRepo.Save(ctx, series)
+	if err != nil {
+		return web.CreateSeriesResponse{}, err
+	}
+
+	return helper.ToCreateSeriesResponse(series), nil
+}
+
+func (service *SeriesServiceImpl) GetAllSeries(ctx context.Context, queries web.QueryParamsGetSeries) ([]web.GetSeriesResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.GetSeriesResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, series are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.GetSeriesResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.GetSeriesResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of SeriesRepository
+	seriesRepo := tx.GetSeriesRepository()
+
+	// Series are listed alphabetically unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "name")
+
+	// Call repository
+	seriesList, total, err := seriesRepo.FindAll(ctx, userId, queries.Name, queries.AuthorId, page)
+	if err != nil {
+		return []web.GetSeriesResponse{}, web.PaginationMeta{}, err
+	}
+
+	// No records return []
+	if len(seriesList) == 0 {
+		return []web.GetSeriesResponse{}, helper.ToPaginationMeta(page, total), nil
+	}
+
+	return helper.ToGetSeriesListResponse(seriesList), helper.ToPaginationMeta(page, total), nil
+}
+
+func (service *SeriesServiceImpl) GetSeriesById(ctx context.Context, pathValues web.PathParamsGetSeries) (web.GetSeriesResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.GetSeriesResponse{}, err
+	}
+
+	// Get the authenticated user, series are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GetSeriesResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GetSeriesResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of SeriesRepository
+	seriesRepo := tx.GetSeriesRepository()
+
+	// Call repository
+	series, err := seriesRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.GetSeriesResponse{}, seriesNotFoundError(pathValues.Id)
+		}
+		return web.GetSeriesResponse{}, err
+	}
+
+	return helper.ToGetSeriesResponse(series), nil
+}
+
+func (service *SeriesServiceImpl) UpdateSeriesById(ctx context.Context, pathValues web.PathParamsUpdateSeries, request web.UpdateSeriesRequest) (web.UpdateSeriesResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+
+	// Get the authenticated user, series are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of SeriesRepository
+	seriesRepo := tx.GetSeriesRepository()
+
+	// Check if id is exists
+	series, err := seriesRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// If id is not found, return earlier
+			return web.UpdateSeriesResponse{}, seriesNotFoundError(pathValues.Id)
+		}
+		return web.UpdateSeriesResponse{}, err
+	}
+
+	// Check if author exists
+	authorNotFound, err := findSeriesAuthor(ctx, tx, request.AuthorId)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+	errAggregate = append(errAggregate, authorNotFound...)
+
+	// Check if the author already has a series with the same name, the series itself is left out
+	err = seriesRepo.CheckByNameAndAuthorId(ctx, userId, request.Name, request.AuthorId)
+	if err != nil {
+		if series.Name != request.Name || series.AuthorId != request.AuthorId {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "name",
+				Message: fmt.Sprintf("series %s is already exists", request.Name),
+			})
+		}
+		err = nil
+	}
+
+	if len(errAggregate) != 0 {
+		return web.UpdateSeriesResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	series = domain.Series{
+		Id:       pathValues.Id,
+		OwnerId:  userId,
+		Name:     request.Name,
+		AuthorId: request.AuthorId,
+	}
+
+	// Call repository
+	series, err = seriesRepo.Update(ctx, userId, pathValues.Id, series)
+	if err != nil {
+		return web.UpdateSeriesResponse{}, err
+	}
+
+	return helper.ToUpdateSeriesResponse(series), nil
+}
+
+func (service *SeriesServiceImpl) DeleteSeriesById(ctx context.Context, pathValues web.PathParamsDeleteSeries) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, series are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of SeriesRepository
+	seriesRepo := tx.GetSeriesRepository()
+
+	// Check if id is exists
+	_, err = seriesRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// if id not found, return earlier
+			return seriesNotFoundError(pathValues.Id)
+		}
+		return err
+	}
+
+	// Call repository, the books of the series are kept without a series
+	err = seriesRepo.Delete(ctx, userId, pathValues.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *SeriesServiceImpl) GetSeriesBooks(ctx context.Context, pathValues web.PathParamsGetSeries) ([]web.SeriesVolumeResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.SeriesVolumeResponse{}, err
+	}
+
+	// Get the authenticated user, only their own books are listed
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.SeriesVolumeResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.SeriesVolumeResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if id is exists
+	_, err = tx.GetSeriesRepository().FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []web.SeriesVolumeResponse{}, seriesNotFoundError(pathValues.Id)
+		}
+		return []web.SeriesVolumeResponse{}, err
+	}
+
+	// Call repository, volumes come in series order
+	books, err := tx.GetBookRepository().FindAllBySeriesId(ctx, userId, pathValues.Id)
+	if err != nil {
+		return []web.SeriesVolumeResponse{}, err
+	}
+
+	return helper.ToSeriesVolumeResponses(books), nil
+}
+
+func (service *SeriesServiceImpl) GetNextUnreadInSeries(ctx context.Context, pathValues web.PathParamsGetSeries) (web.SeriesVolumeResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.SeriesVolumeResponse{}, err
+	}
+
+	// Get the authenticated user, only their own books are considered
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.SeriesVolumeResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.SeriesVolumeResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Check if id is exists
+	_, err = tx.GetSeriesRepository().FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.SeriesVolumeResponse{}, seriesNotFoundError(pathValues.Id)
+		}
+		return web.SeriesVolumeResponse{}, err
+	}
+
+	// Call repository
+	book, err := tx.GetBookRepository().FindNextUnreadInSeries(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Every volume the user owns is completed, or they own none
+			return web.SeriesVolumeResponse{}, appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("there is no unread book in series with id '%s'", pathValues.Id),
+					},
+				},
+				fmt.Errorf("there is no unread book in series with id '%s'", pathValues.Id),
+			)
+		}
+		return web.SeriesVolumeResponse{}, err
+	}
+
+	return helper.ToSeriesVolumeResponse(book), nil
+}
+
+// findSeriesAuthor checks the optional author of a series,
+// a series without an author is always valid
+func findSeriesAuthor(ctx context.Context, tx Transaction, authorId string) ([]appError.ErrAggregate, error) {
+	if authorId == "" {
+		return nil, nil
+	}
+
+	_, err := tx.GetAuthorRepository().FindById(ctx, authorId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		return []appError.ErrAggregate{{
+			Field:   "author_id",
+			Message: fmt.Sprintf("author with id '%v' is not found", authorId),
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+func seriesNotFoundError(seriesId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   "id",
+				Message: fmt.Sprintf("series with id '%s' is not found", seriesId),
+			},
+		},
+		fmt.Errorf("series with id '%s' is not found", seriesId),
+	)
+}
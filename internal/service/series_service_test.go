@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+// MockSeriesRepository keeps series in memory and scopes them to their owner like the SQL does
+type MockSeriesRepository struct {
+	repository.SeriesRepository
+
+	Series map[string]domain.Series
+}
+
+func (m *MockSeriesRepository) FindById(ctx context.Context, ownerId, seriesId string) (domain.Series, error) {
+	series, ok := m.Series[seriesId]
+	if !ok || series.OwnerId != ownerId {
+		return domain.Series{}, pgx.ErrNoRows
+	}
+
+	return series, nil
+}
+
+func (m *MockSeriesRepository) Delete(ctx context.Context, ownerId, seriesId string) error {
+	if series, ok := m.Series[seriesId]; ok && series.OwnerId == ownerId {
+		delete(m.Series, seriesId)
+	}
+
+	return nil
+}
+
+func TestSeriesOwnerScope(t *testing.T) {
+	seriesOfB := domain.Series{
+		Id:      "8f14e45f-ceea-4e7a-9b1c-2d3e4f5a6b7c",
+		OwnerId: ownerB,
+		Name:    "The Expanse",
+	}
+
+	newService := func() (SeriesService, *MockSeriesRepository) {
+		seriesRepo := &MockSeriesRepository{Series: map[string]domain.Series{seriesOfB.Id: seriesOfB}}
+		uow := &MockUnitOfWork{Tx: &MockTransaction{SeriesRepo: seriesRepo}}
+		return NewSeriesService(uow, config.ValidatorInit()), seriesRepo
+	}
+
+	ctxA := helper.WithUserId(context.Background(), ownerA)
+
+	t.Run("get a series of another owner", func(t *testing.T) {
+		service, _ := newService()
+
+		_, err := service.GetSeriesById(ctxA, web.PathParamsGetSeries{Id: seriesOfB.Id})
+		expectStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("rename a series of another owner", func(t *testing.T) {
+		service, seriesRepo := newService()
+
+		_, err := service.UpdateSeriesById(ctxA, web.PathParamsUpdateSeries{Id: seriesOfB.Id}, web.UpdateSeriesRequest{
+			Name: "Taken Over",
+		})
+		expectStatus(t, err, http.StatusNotFound)
+
+		if seriesRepo.Series[seriesOfB.Id].Name != seriesOfB.Name {
+			t.Errorf("expected the series of the other owner to keep its name but got %s", seriesRepo.Series[seriesOfB.Id].Name)
+		}
+	})
+
+	t.Run("delete a series of another owner", func(t *testing.T) {
+		service, seriesRepo := newService()
+
+		err := service.DeleteSeriesById(ctxA, web.PathParamsDeleteSeries{Id: seriesOfB.Id})
+		expectStatus(t, err, http.StatusNotFound)
+
+		if _, ok := seriesRepo.Series[seriesOfB.Id]; !ok {
+			t.Error("expected the series of the other owner to be kept")
+		}
+	})
+
+	t.Run("delete own series", func(t *testing.T) {
+		service, seriesRepo := newService()
+
+		err := service.DeleteSeriesById(helper.WithUserId(context.Background(), ownerB), web.PathParamsDeleteSeries{Id: seriesOfB.Id})
+		if err != nil {
+			t.Fatalf("expected no error but got %v", err)
+		}
+
+		if _, ok := seriesRepo.Series[seriesOfB.Id]; ok {
+			t.Error("expected the series to be deleted")
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type StatsService interface {
+	GetStats(ctx context.Context, queries web.QueryParamsGetStats) (web.StatsResponse, error)
+}
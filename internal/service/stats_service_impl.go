@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+const (
+	// statsTopAuthors is the number of authors in the top authors ranking
+	statsTopAuthors = 10
+
+	// statsMaxDays is the longest range that can be grouped by day
+	statsMaxDays = 366
+)
+
+func NewStatsService(uow UnitOfWork, validate *validator.Validate) StatsService {
+	return &StatsServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type StatsServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *StatsServiceImpl) GetStats(ctx context.Context, queries web.QueryParamsGetStats) (web.StatsResponse, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	// Get the authenticated user, statistics are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	// The range defaults to the current year up to today, grouped by month
+	now := time.Now()
+	from := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	groupBy := domain.StatsGroupByMonth
+
+	// Dates are already validated as YYYY-MM-DD
+	if queries.From != "" {
+		from, _ = time.Parse("2006-01-02", queries.From)
+	}
+	if queries.To != "" {
+		to, _ = time.Parse("2006-01-02", queries.To)
+	}
+	if queries.GroupBy != "" {
+		groupBy = queries.GroupBy
+	}
+
+	if from.After(to) {
+		return web.StatsResponse{}, statsRangeError("from must not be after to")
+	}
+
+	if groupBy == domain.StatsGroupByDay && to.Sub(from) >= statsMaxDays*24*time.Hour {
+		return web.StatsResponse{}, statsRangeError("range must not be longer than 366 days when grouped by day")
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of StatsRepository
+	statsRepo := tx.GetStatsRepository()
+
+	var stats domain.Stats
+
+	// Every aggregate is computed by the database
+	stats.Totals, err = statsRepo.FindTotals(ctx, userId, from, to)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	stats.Timeline, err = statsRepo.FindTimeline(ctx, userId, from, to, groupBy)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	stats.Status, err = statsRepo.FindStatusCounts(ctx, userId)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	stats.TopAuthors, err = statsRepo.FindTopAuthors(ctx, userId, from, to, statsTopAuthors)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	stats.Nationalities, err = statsRepo.FindNationalities(ctx, userId, from, to)
+	if err != nil {
+		return web.StatsResponse{}, err
+	}
+
+	return helper.ToStatsResponse(stats, from, to, groupBy), nil
+}
+
+func statsRangeError(message string) error {
+	return appError.NewAppError(
+		http.StatusBadRequest,
+		[]appError.ErrAggregate{
+			{
+				Field:   "from",
+				Message: message,
+			},
+		},
+		nil,
+	)
+}
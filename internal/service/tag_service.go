@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+type TagService interface {
+	CreateNewTag(ctx context.Context, request web.CreateTagRequest) (web.CreateTagResponse, error)
+	GetAllTags(ctx context.Context, queries web.QueryParamsGetTags) ([]web.GetTagResponse, web.PaginationMeta, error)
+	GetTagById(ctx context.Context, pathValues web.PathParamsGetTag) (web.GetTagResponse, error)
+	UpdateTagById(ctx context.Context, pathValues web.PathParamsUpdateTag, request web.UpdateTagRequest) (web.UpdateTagResponse, error)
+	DeleteTagById(ctx context.Context, pathValues web.PathParamsDeleteTag) error
+	AttachTagToBook(ctx context.Context, pathValues web.PathParamsBookTag) ([]web.BookTagResponse, error)
+	DetachTagFromBook(ctx context.Context, pathValues web.PathParamsBookTag) error
+}
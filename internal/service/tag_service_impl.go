@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
+	"github.com/mhaatha/go-bookshelf/internal/model/web"
+)
+
+func NewTagService(uow UnitOfWork, validate *validator.Validate) TagService {
+	return &TagServiceImpl{
+		UoW:      uow,
+		Validate: validate,
+	}
+}
+
+type TagServiceImpl struct {
+	UoW      UnitOfWork
+	Validate *validator.Validate
+}
+
+func (service *TagServiceImpl) CreateNewTag(ctx context.Context, request web.CreateTagRequest) (web.CreateTagResponse, error) {
+	// Validate request body
+	err := service.Validate.Struct(request)
+	if err != nil {
+		return web.CreateTagResponse{}, err
+	}
+
+	// Get the authenticated user, tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.CreateTagResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.CreateTagResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Check if name already exists
+	err = tagRepo.CheckByName(ctx, userId, request.Name)
+	if err != nil {
+		errAggregate = append(errAggregate, appError.ErrAggregate{
+			Field:   "name",
+			Message: fmt.Sprintf("tag %s is already exists", request.Name),
+		})
+	}
+
+	if len(errAggregate) != 0 {
+		return web.CreateTagResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	tag := domain.Tag{
+		OwnerId: userId,
+		Name:    request.Name,
+	}
+
+	// Call repository
+	tag, err = tagRepo.Save(ctx, tag)
+	if err != nil {
+		return web.CreateTagResponse{}, err
+	}
+
+	return helper.ToCreateTagResponse(tag), nil
+}
+
+func (service *TagServiceImpl) GetAllTags(ctx context.Context, queries web.QueryParamsGetTags) ([]web.GetTagResponse, web.PaginationMeta, error) {
+	// Validate queries
+	err := service.Validate.Struct(queries)
+	if err != nil {
+		return []web.GetTagResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Get the authenticated user, tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.GetTagResponse{}, web.PaginationMeta{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.GetTagResponse{}, web.PaginationMeta{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Tags are listed alphabetically unless sort is given
+	page := helper.ToPage(queries.Page, queries.PageSize, queries.Sort, "name")
+
+	// Call repository
+	tags, total, err := tagRepo.FindAll(ctx, userId, queries.Name, page)
+	if err != nil {
+		return []web.GetTagResponse{}, web.PaginationMeta{}, err
+	}
+
+	// No records return []
+	if len(tags) == 0 {
+		return []web.GetTagResponse{}, helper.ToPaginationMeta(page, total), nil
+	}
+
+	return helper.ToGetTagsResponse(tags), helper.ToPaginationMeta(page, total), nil
+}
+
+func (service *TagServiceImpl) GetTagById(ctx context.Context, pathValues web.PathParamsGetTag) (web.GetTagResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.GetTagResponse{}, err
+	}
+
+	// Get the authenticated user, tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.GetTagResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.GetTagResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Call repository
+	tag, err := tagRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return web.GetTagResponse{}, tagNotFoundError("id", pathValues.Id)
+		}
+		return web.GetTagResponse{}, err
+	}
+
+	return helper.ToGetTagResponse(tag), nil
+}
+
+func (service *TagServiceImpl) UpdateTagById(ctx context.Context, pathValues web.PathParamsUpdateTag, request web.UpdateTagRequest) (web.UpdateTagResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return web.UpdateTagResponse{}, err
+	}
+
+	// Validate request body
+	err = service.Validate.Struct(request)
+	if err != nil {
+		return web.UpdateTagResponse{}, err
+	}
+
+	// Get the authenticated user, tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return web.UpdateTagResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return web.UpdateTagResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// errAggregate aggregates errors from user bad request
+	errAggregate := []appError.ErrAggregate{}
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Check if id is exists
+	tag, err := tagRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// If id is not found, return earlier
+			return web.UpdateTagResponse{}, tagNotFoundError("id", pathValues.Id)
+		}
+		return web.UpdateTagResponse{}, err
+	}
+
+	// Check if name already exists, a tag may be renamed to a different case of its own name
+	err = tagRepo.CheckByName(ctx, userId, request.Name)
+	if err != nil {
+		if !strings.EqualFold(tag.Name, request.Name) {
+			errAggregate = append(errAggregate, appError.ErrAggregate{
+				Field:   "name",
+				Message: fmt.Sprintf("tag %s is already exists", request.Name),
+			})
+		}
+		err = nil
+	}
+
+	if len(errAggregate) != 0 {
+		return web.UpdateTagResponse{}, appError.NewAppError(
+			http.StatusBadRequest,
+			errAggregate,
+			nil,
+		)
+	}
+
+	tag.Name = request.Name
+
+	// Call repository
+	tag, err = tagRepo.Update(ctx, userId, pathValues.Id, tag)
+	if err != nil {
+		return web.UpdateTagResponse{}, err
+	}
+
+	return helper.ToUpdateTagResponse(tag), nil
+}
+
+func (service *TagServiceImpl) DeleteTagById(ctx context.Context, pathValues web.PathParamsDeleteTag) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Check if id is exists
+	_, err = tagRepo.FindById(ctx, userId, pathValues.Id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// if id not found, return earlier
+			return tagNotFoundError("id", pathValues.Id)
+		}
+		return err
+	}
+
+	// Call repository, the tag is detached from its books as well
+	err = tagRepo.Delete(ctx, userId, pathValues.Id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (service *TagServiceImpl) AttachTagToBook(ctx context.Context, pathValues web.PathParamsBookTag) ([]web.BookTagResponse, error) {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+
+	// Get the authenticated user, books and tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of BookRepository and TagRepository
+	bookRepo := tx.GetBookRepository()
+	tagRepo := tx.GetTagRepository()
+
+	// Both the book and the tag must belong to the user
+	err = service.checkBookAndTag(ctx, tx, userId, pathValues)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+
+	// Call repository
+	err = tagRepo.AttachToBook(ctx, pathValues.BookId, pathValues.TagId)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+
+	// Return every tag of the book after attaching
+	book, err := bookRepo.FindById(ctx, userId, pathValues.BookId)
+	if err != nil {
+		return []web.BookTagResponse{}, err
+	}
+
+	return helper.ToBookTagResponses(book.Tags), nil
+}
+
+func (service *TagServiceImpl) DetachTagFromBook(ctx context.Context, pathValues web.PathParamsBookTag) error {
+	// Validate path params
+	err := service.Validate.Struct(pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Get the authenticated user, books and tags are scoped to their owner
+	userId, err := userIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open transaction
+	tx, err := service.UoW.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback(ctx)
+			panic(r)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// It creates a new instance of TagRepository
+	tagRepo := tx.GetTagRepository()
+
+	// Both the book and the tag must belong to the user
+	err = service.checkBookAndTag(ctx, tx, userId, pathValues)
+	if err != nil {
+		return err
+	}
+
+	// Call repository
+	detached, err := tagRepo.DetachFromBook(ctx, pathValues.BookId, pathValues.TagId)
+	if err != nil {
+		return err
+	}
+
+	if !detached {
+		return appError.NewAppError(
+			http.StatusNotFound,
+			[]appError.ErrAggregate{
+				{
+					Field:   "tag_id",
+					Message: fmt.Sprintf("tag with id '%s' is not attached to book with id '%s'", pathValues.TagId, pathValues.BookId),
+				},
+			},
+			fmt.Errorf("tag with id '%s' is not attached to book with id '%s'", pathValues.TagId, pathValues.BookId),
+		)
+	}
+
+	return nil
+}
+
+// checkBookAndTag returns a 404 AppError when the book or the tag is not owned by the user
+func (service *TagServiceImpl) checkBookAndTag(ctx context.Context, tx Transaction, userId string, pathValues web.PathParamsBookTag) error {
+	_, err := tx.GetBookRepository().FindById(ctx, userId, pathValues.BookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return appError.NewAppError(
+				http.StatusNotFound,
+				[]appError.ErrAggregate{
+					{
+						Field:   "id",
+						Message: fmt.Sprintf("book with id '%s' is not found", pathValues.BookId),
+					},
+				},
+				fmt.Errorf("book with id '%s' is not found", pathValues.BookId),
+			)
+		}
+		return err
+	}
+
+	_, err = tx.GetTagRepository().FindById(ctx, userId, pathValues.TagId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return tagNotFoundError("tag_id", pathValues.TagId)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func tagNotFoundError(field, tagId string) error {
+	return appError.NewAppError(
+		http.StatusNotFound,
+		[]appError.ErrAggregate{
+			{
+				Field:   field,
+				Message: fmt.Sprintf("tag with id '%s' is not found", tagId),
+			},
+		},
+		fmt.Errorf("tag with id '%s' is not found", tagId),
+	)
+}
@@ -12,6 +12,19 @@ type Transaction interface {
 
 	GetAuthorRepository() repository.AuthorRepository
 	GetBookRepository() repository.BookRepository
+	GetUserRepository() repository.UserRepository
+	GetRefreshTokenRepository() repository.RefreshTokenRepository
+	GetReadingSessionRepository() repository.ReadingSessionRepository
+	GetSearchRepository() repository.SearchRepository
+	GetTagRepository() repository.TagRepository
+	GetSeriesRepository() repository.SeriesRepository
+	GetReviewRepository() repository.ReviewRepository
+	GetNoteRepository() repository.NoteRepository
+	GetHighlightRepository() repository.HighlightRepository
+	GetGoalRepository() repository.GoalRepository
+	GetStatsRepository() repository.StatsRepository
+	GetReadRepository() repository.ReadRepository
+	GetAuthorAliasRepository() repository.AuthorAliasRepository
 }
 
 type UnitOfWork interface {
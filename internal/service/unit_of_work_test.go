@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/mhaatha/go-bookshelf/internal/repository"
+)
+
+// MockUnitOfWork hands out a single MockTransaction, repositories left nil are not expected to be used by the test
+type MockUnitOfWork struct {
+	Tx *MockTransaction
+}
+
+func (m *MockUnitOfWork) Begin(ctx context.Context) (Transaction, error) {
+	return m.Tx, nil
+}
+
+type MockTransaction struct {
+	Committed  atomic.Bool
+	RolledBack atomic.Bool
+
+	AuthorRepo         repository.AuthorRepository
+	BookRepo           repository.BookRepository
+	UserRepo           repository.UserRepository
+	RefreshTokenRepo   repository.RefreshTokenRepository
+	ReadingSessionRepo repository.ReadingSessionRepository
+	SearchRepo         repository.SearchRepository
+	TagRepo            repository.TagRepository
+	SeriesRepo         repository.SeriesRepository
+	ReviewRepo         repository.ReviewRepository
+	NoteRepo           repository.NoteRepository
+	HighlightRepo      repository.HighlightRepository
+	GoalRepo           repository.GoalRepository
+	StatsRepo          repository.StatsRepository
+	ReadRepo           repository.ReadRepository
+	AuthorAliasRepo    repository.AuthorAliasRepository
+}
+
+func (m *MockTransaction) Commit(ctx context.Context) error {
+	m.Committed.Store(true)
+	return nil
+}
+
+func (m *MockTransaction) Rollback(ctx context.Context) error {
+	m.RolledBack.Store(true)
+	return nil
+}
+
+func (m *MockTransaction) GetAuthorRepository() repository.AuthorRepository { return m.AuthorRepo }
+func (m *MockTransaction) GetBookRepository() repository.BookRepository     { return m.BookRepo }
+func (m *MockTransaction) GetUserRepository() repository.UserRepository     { return m.UserRepo }
+func (m *MockTransaction) GetRefreshTokenRepository() repository.RefreshTokenRepository {
+	return m.RefreshTokenRepo
+}
+func (m *MockTransaction) GetReadingSessionRepository() repository.ReadingSessionRepository {
+	return m.ReadingSessionRepo
+}
+func (m *MockTransaction) GetSearchRepository() repository.SearchRepository { return m.SearchRepo }
+func (m *MockTransaction) GetTagRepository() repository.TagRepository       { return m.TagRepo }
+func (m *MockTransaction) GetSeriesRepository() repository.SeriesRepository { return m.SeriesRepo }
+func (m *MockTransaction) GetReviewRepository() repository.ReviewRepository { return m.ReviewRepo }
+func (m *MockTransaction) GetNoteRepository() repository.NoteRepository     { return m.NoteRepo }
+func (m *MockTransaction) GetHighlightRepository() repository.HighlightRepository {
+	return m.HighlightRepo
+}
+func (m *MockTransaction) GetGoalRepository() repository.GoalRepository   { return m.GoalRepo }
+func (m *MockTransaction) GetStatsRepository() repository.StatsRepository { return m.StatsRepo }
+func (m *MockTransaction) GetReadRepository() repository.ReadRepository   { return m.ReadRepo }
+func (m *MockTransaction) GetAuthorAliasRepository() repository.AuthorAliasRepository {
+	return m.AuthorAliasRepo
+}
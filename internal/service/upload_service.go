@@ -8,4 +8,5 @@ import (
 
 type UploadService interface {
 	GetBookPresignedURL(ctx context.Context) (web.GetBookPresignedURLResponse, error)
+	GetAuthorPresignedURL(ctx context.Context) (web.GetAuthorPresignedURLResponse, error)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mhaatha/go-bookshelf/internal/config"
+	"github.com/mhaatha/go-bookshelf/internal/model/domain"
 	"github.com/mhaatha/go-bookshelf/internal/model/web"
 	"github.com/minio/minio-go/v7"
 )
@@ -23,23 +24,45 @@ type UploadServiceImpl struct {
 }
 
 func (service *UploadServiceImpl) GetBookPresignedURL(ctx context.Context) (web.GetBookPresignedURLResponse, error) {
+	url, formData, err := service.presignedPostPolicy(ctx, service.Config.BookBucket, "")
+	if err != nil {
+		return web.GetBookPresignedURLResponse{}, err
+	}
+
+	return web.GetBookPresignedURLResponse{
+		URL:      url,
+		FormData: formData,
+	}, nil
+}
+
+func (service *UploadServiceImpl) GetAuthorPresignedURL(ctx context.Context) (web.GetAuthorPresignedURLResponse, error) {
+	url, formData, err := service.presignedPostPolicy(ctx, service.Config.AuthorBucket, domain.AuthorPhotoKeyPrefix)
+	if err != nil {
+		return web.GetAuthorPresignedURLResponse{}, err
+	}
+
+	return web.GetAuthorPresignedURLResponse{
+		URL:      url,
+		FormData: formData,
+	}, nil
+}
+
+// presignedPostPolicy lets a client upload one JPEG of 1KB to 5MB to the bucket under a new key with the given prefix
+func (service *UploadServiceImpl) presignedPostPolicy(ctx context.Context, bucket, keyPrefix string) (string, map[string]string, error) {
 	// Initialize policy condition config
 	policy := minio.NewPostPolicy()
 
-	policy.SetBucket(service.Config.BookBucket)
-	policy.SetKey(uuid.NewString() + ".jpg")
+	policy.SetBucket(bucket)
+	policy.SetKey(keyPrefix + uuid.NewString() + ".jpg")
 	policy.SetContentLengthRange(1024, 5*1024*1024) // 1KB - 5MB
 	policy.SetContentType("image/jpeg")
 	policy.SetExpires(time.Now().UTC().Add(5 * time.Minute))
 
 	// Get the POST form key/value object:
-	url, formData, err := service.MinIOClient.PresignedPostPolicy(context.Background(), policy)
+	url, formData, err := service.MinIOClient.PresignedPostPolicy(ctx, policy)
 	if err != nil {
-		return web.GetBookPresignedURLResponse{}, err
+		return "", nil, err
 	}
 
-	return web.GetBookPresignedURLResponse{
-		URL:      url.String(),
-		FormData: formData,
-	}, nil
+	return url.String(), formData, nil
 }
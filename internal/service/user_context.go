@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	appError "github.com/mhaatha/go-bookshelf/internal/errors"
+	"github.com/mhaatha/go-bookshelf/internal/helper"
+)
+
+// userIdFromContext returns the authenticated user id placed in ctx by the auth middleware
+func userIdFromContext(ctx context.Context) (string, error) {
+	userId, ok := helper.UserIdFromContext(ctx)
+	if !ok {
+		return "", appError.NewAppError(
+			http.StatusUnauthorized,
+			[]appError.ErrAggregate{
+				{
+					Field:   "authorization",
+					Message: "authenticated user is required",
+				},
+			},
+			errors.New("user id is missing from request context"),
+		)
+	}
+
+	return userId, nil
+}